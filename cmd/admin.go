@@ -1,12 +1,38 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"gitlab.com/abyss.club/uexky/cmd/devtools"
+	"gitlab.com/abyss.club/uexky/lib/algo"
+	"gitlab.com/abyss.club/uexky/uexky"
+	"gitlab.com/abyss.club/uexky/uexky/entity"
 )
 
+var modLogFlags struct {
+	after string
+	limit int
+}
+
+var roleSetFlags struct {
+	tag    string
+	reason string
+}
+
 func init() {
-	adminCmd.AddCommand(devtools.SetMainTagsCmd)
+	modLogCmd.PersistentFlags().StringVar(&modLogFlags.after, "after", "", "cursor to list logs after, from the beginning if empty")
+	modLogCmd.PersistentFlags().IntVar(&modLogFlags.limit, "limit", 20, "count of logs")
+	roleSetCmd.PersistentFlags().StringVar(&roleSetFlags.tag, "tag", "", "set the role granted in the main tag")
+	roleSetCmd.PersistentFlags().StringVar(&roleSetFlags.reason, "reason", "", "reason recorded in moderation log")
+	roleCmd.AddCommand(roleSetCmd, roleListCmd)
+	tagsCmd.AddCommand(tagsListCmd, tagsAddCmd, tagsRenameCmd, tagsRetireCmd, tagsReorderCmd, tagsMergeCmd, tagsAliasesCmd, tagsIndexCmd)
+	adminCmd.AddCommand(devtools.SetMainTagsCmd, modLogCmd, roleCmd, tagsCmd)
 }
 
 var adminCmd = &cobra.Command{
@@ -14,3 +40,229 @@ var adminCmd = &cobra.Command{
 	Short: "admin utils",
 	Long:  "utils for administrator",
 }
+
+var modLogCmd = &cobra.Command{
+	Use:   "modlog",
+	Short: "list moderation logs, newest first",
+	Run: func(cmd *cobra.Command, args []string) {
+		service, err := uexky.InitUexkyService()
+		if err != nil {
+			log.Fatal(err)
+		}
+		ctx := service.TxAdapter.AttachDB(context.Background())
+		slice, err := service.Repo.ModLog.GetSlice(ctx, entity.SliceQuery{
+			After: algo.NullString(modLogFlags.after),
+			Limit: modLogFlags.limit,
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "TIME\tACTOR\tACTION\tTARGET\tREASON\tBEFORE\tAFTER")
+		for _, l := range slice.Logs {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				l.CreatedAt.Format("2006-01-02 15:04:05"), l.ActorID.ToBase64String(), l.Action,
+				l.TargetID.ToBase64String(), algo.NullToString(l.Reason), l.Before, l.After)
+		}
+		if err := w.Flush(); err != nil {
+			log.Fatal(err)
+		}
+		if slice.SliceInfo.HasNext {
+			fmt.Printf("more logs after cursor: %s\n", slice.SliceInfo.LastCursor)
+		}
+	},
+}
+
+var roleCmd = &cobra.Command{
+	Use:   "role",
+	Short: "manage roles of users",
+}
+
+var roleSetCmd = &cobra.Command{
+	Use:   "set email role",
+	Short: "set role of user, or the role granted in main tag with --tag",
+	Long:  "role can be admin, mod or normal, only mod can be granted in main tag and normal revokes it",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		service, err := uexky.InitUexkyService()
+		if err != nil {
+			log.Fatal(err)
+		}
+		ctx := service.TxAdapter.AttachDB(context.Background())
+		ctx = entity.NewCLIUser().AttachContext(ctx)
+		var tag, reason *string
+		if roleSetFlags.tag != "" {
+			tag = &roleSetFlags.tag
+		}
+		if roleSetFlags.reason != "" {
+			reason = &roleSetFlags.reason
+		}
+		user, err := service.SetUserRole(ctx, &args[0], nil, entity.Role(args[1]), tag, reason)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("role of %s: %s, tag roles: %v\n", args[0], user.Role, user.TagRoles)
+	},
+}
+
+var roleListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "list admins and moderators, including moderators of main tags",
+	Run: func(cmd *cobra.Command, args []string) {
+		service, err := uexky.InitUexkyService()
+		if err != nil {
+			log.Fatal(err)
+		}
+		ctx := service.TxAdapter.AttachDB(context.Background())
+		users, err := service.Repo.User.GetStaff(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tEMAIL\tNAME\tROLE\tTAG ROLES")
+		for _, u := range users {
+			var tagRoles []string
+			for _, tr := range u.TagRoles {
+				tagRoles = append(tagRoles, fmt.Sprintf("%s:%s", tr.Tag, tr.Role))
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", u.ID.ToBase64String(), algo.NullToString(u.Email),
+				algo.NullToString(u.Name), u.Role, strings.Join(tagRoles, ","))
+		}
+		if err := w.Flush(); err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+var tagsCmd = &cobra.Command{
+	Use:   "tags",
+	Short: "manage main tags, running servers reload them without restart",
+}
+
+// runTagsCmd runs fn as command line user and prints main tags after changed.
+func runTagsCmd(fn func(ctx context.Context, service *uexky.Service) ([]string, error)) {
+	service, err := uexky.InitUexkyService()
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx := service.TxAdapter.AttachDB(context.Background())
+	ctx = entity.NewCLIUser().AttachContext(ctx)
+	mainTags, err := fn(ctx, service)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("main tags: %s\n", strings.Join(mainTags, ","))
+}
+
+var tagsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "list main tags in order",
+	Run: func(cmd *cobra.Command, args []string) {
+		runTagsCmd(func(ctx context.Context, service *uexky.Service) ([]string, error) {
+			return service.GetMainTags(ctx), nil
+		})
+	},
+}
+
+var tagsAddCmd = &cobra.Command{
+	Use:   "add tag",
+	Short: "add a main tag to the end, a retired main tag is restored",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runTagsCmd(func(ctx context.Context, service *uexky.Service) ([]string, error) {
+			return service.AddMainTag(ctx, args[0])
+		})
+	},
+}
+
+var tagsRenameCmd = &cobra.Command{
+	Use:   "rename tag new_name",
+	Short: "rename a main tag, threads and users with it are updated as well",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		runTagsCmd(func(ctx context.Context, service *uexky.Service) ([]string, error) {
+			return service.RenameMainTag(ctx, args[0], args[1])
+		})
+	},
+}
+
+var tagsRetireCmd = &cobra.Command{
+	Use:   "retire tag",
+	Short: "retire a main tag, new threads can't use it but existing threads keep it",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runTagsCmd(func(ctx context.Context, service *uexky.Service) ([]string, error) {
+			return service.RetireMainTag(ctx, args[0])
+		})
+	},
+}
+
+var tagsReorderCmd = &cobra.Command{
+	Use:   "reorder tag...",
+	Short: "sort main tags, all main tags must be specified",
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runTagsCmd(func(ctx context.Context, service *uexky.Service) ([]string, error) {
+			return service.ReorderMainTags(ctx, args)
+		})
+	},
+}
+
+var tagsMergeCmd = &cobra.Command{
+	Use:   "merge from into",
+	Short: "merge sub tag into another, from becomes an alias of into",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		service, err := uexky.InitUexkyService()
+		if err != nil {
+			log.Fatal(err)
+		}
+		ctx := service.TxAdapter.AttachDB(context.Background())
+		ctx = entity.NewCLIUser().AttachContext(ctx)
+		if _, err := service.MergeTags(ctx, args[0], args[1]); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%s is merged into %s\n", args[0], args[1])
+	},
+}
+
+var tagsAliasesCmd = &cobra.Command{
+	Use:   "aliases",
+	Short: "list tag aliases",
+	Run: func(cmd *cobra.Command, args []string) {
+		service, err := uexky.InitUexkyService()
+		if err != nil {
+			log.Fatal(err)
+		}
+		ctx := service.TxAdapter.AttachDB(context.Background())
+		aliases, err := service.Repo.Tag.GetAliases(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "ALIAS\tTAG")
+		for alias, tag := range aliases {
+			fmt.Fprintf(w, "%s\t%s\n", alias, tag)
+		}
+		if err := w.Flush(); err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+var tagsIndexCmd = &cobra.Command{
+	Use:   "index",
+	Short: "index pinyin of tags for searching, run it after migrating an existing database",
+	Run: func(cmd *cobra.Command, args []string) {
+		service, err := uexky.InitUexkyService()
+		if err != nil {
+			log.Fatal(err)
+		}
+		ctx := service.TxAdapter.AttachDB(context.Background())
+		count, err := service.Repo.Tag.IndexPinyin(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%v tags indexed\n", count)
+	},
+}
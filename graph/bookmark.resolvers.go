@@ -0,0 +1,24 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"gitlab.com/abyss.club/uexky/graph/generated"
+	"gitlab.com/abyss.club/uexky/lib/uid"
+)
+
+func (r *mutationResolver) Bookmark(ctx context.Context, threadID *uid.UID, postID *uid.UID) (bool, error) {
+	return r.Uexky.Bookmark(ctx, threadID, postID)
+}
+
+func (r *mutationResolver) Unbookmark(ctx context.Context, threadID *uid.UID, postID *uid.UID) (bool, error) {
+	return r.Uexky.Unbookmark(ctx, threadID, postID)
+}
+
+// Mutation returns generated.MutationResolver implementation.
+func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
+
+type mutationResolver struct{ *Resolver }
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -38,9 +39,12 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	ModLog() ModLogResolver
 	Mutation() MutationResolver
 	Post() PostResolver
 	Query() QueryResolver
+	Report() ReportResolver
+	Subscription() SubscriptionResolver
 	Thread() ThreadResolver
 	User() UserResolver
 }
@@ -52,21 +56,86 @@ type ComplexityRoot struct {
 	Author struct {
 		Anonymous func(childComplexity int) int
 		Author    func(childComplexity int) int
+		IsOP      func(childComplexity int) int
+	}
+
+	BookmarkSlice struct {
+		Items     func(childComplexity int) int
+		SliceInfo func(childComplexity int) int
+	}
+
+	ModLog struct {
+		Action    func(childComplexity int) int
+		Actor     func(childComplexity int) int
+		After     func(childComplexity int) int
+		Before    func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Reason    func(childComplexity int) int
+		TargetID  func(childComplexity int) int
+	}
+
+	ModLogSlice struct {
+		Logs      func(childComplexity int) int
+		SliceInfo func(childComplexity int) int
 	}
 
 	Mutation struct {
-		AddSubbedTag func(childComplexity int, tag string) int
-		BanUser      func(childComplexity int, postID *uid.UID, threadID *uid.UID) int
-		BlockPost    func(childComplexity int, postID uid.UID) int
-		BlockThread  func(childComplexity int, threadID uid.UID) int
-		DelSubbedTag func(childComplexity int, tag string) int
-		EditTags     func(childComplexity int, threadID uid.UID, mainTag string, subTags []string) int
-		EmailAuth    func(childComplexity int, email string, redirectTo *string) int
-		LockThread   func(childComplexity int, threadID uid.UID) int
-		PubPost      func(childComplexity int, post entity.PostInput) int
-		PubThread    func(childComplexity int, thread entity.ThreadInput) int
-		SetName      func(childComplexity int, name string) int
-		SyncTags     func(childComplexity int, tags []string) int
+		AddMainTag      func(childComplexity int, tag string) int
+		AddSubbedTag    func(childComplexity int, tag string) int
+		BanNetwork      func(childComplexity int, postID *uid.UID, threadID *uid.UID, prefix *int, reason *string, duration *int) int
+		BanUser         func(childComplexity int, postID *uid.UID, threadID *uid.UID, reason *string, duration *int) int
+		BlockPost       func(childComplexity int, postID uid.UID, reason *string) int
+		BlockThread     func(childComplexity int, threadID uid.UID, reason *string) int
+		Bookmark        func(childComplexity int, threadID *uid.UID, postID *uid.UID) int
+		DelSubbedTag    func(childComplexity int, tag string) int
+		DismissReport   func(childComplexity int, reportID uid.UID) int
+		EditPost        func(childComplexity int, postID uid.UID, content string) int
+		EditTag         func(childComplexity int, name string, description *string, rules *string) int
+		EditTags        func(childComplexity int, threadID uid.UID, mainTag string, subTags []string, reason *string) int
+		EditThread      func(childComplexity int, threadID uid.UID, title *string, content string) int
+		EmailAuth       func(childComplexity int, email string, redirectTo *string) int
+		HideThread      func(childComplexity int, threadID uid.UID) int
+		LockThread      func(childComplexity int, threadID uid.UID, reason *string) int
+		MergeTags       func(childComplexity int, from string, into string) int
+		MuteTag         func(childComplexity int, tag string) int
+		PinThread       func(childComplexity int, threadID uid.UID, until *time.Time, reason *string) int
+		PubPost         func(childComplexity int, post entity.PostInput) int
+		PubThread       func(childComplexity int, thread entity.ThreadInput) int
+		RenameMainTag   func(childComplexity int, tag string, newName string) int
+		ReorderMainTags func(childComplexity int, tags []string) int
+		ReportPost      func(childComplexity int, postID uid.UID, reason entity.ReportReason, text *string) int
+		ReportThread    func(childComplexity int, threadID uid.UID, reason entity.ReportReason, text *string) int
+		ResolveReport   func(childComplexity int, reportID uid.UID, action *entity.ReportAction, reason *string) int
+		RetireMainTag   func(childComplexity int, tag string) int
+		SetAutoWatch    func(childComplexity int, enable bool) int
+		SetName         func(childComplexity int, name string) int
+		SetUserRole     func(childComplexity int, email *string, userID *uid.UID, role entity.Role, tag *string, reason *string) int
+		SyncTags        func(childComplexity int, tags []string) int
+		UnbanNetwork    func(childComplexity int, id uid.UID, reason *string) int
+		UnbanUser       func(childComplexity int, postID *uid.UID, threadID *uid.UID, reason *string) int
+		UnblockPost     func(childComplexity int, postID uid.UID, reason *string) int
+		UnblockThread   func(childComplexity int, threadID uid.UID, reason *string) int
+		Unbookmark      func(childComplexity int, threadID *uid.UID, postID *uid.UID) int
+		UnhideThread    func(childComplexity int, threadID uid.UID) int
+		UnlockThread    func(childComplexity int, threadID uid.UID, reason *string) int
+		UnmuteTag       func(childComplexity int, tag string) int
+		UnpinThread     func(childComplexity int, threadID uid.UID, reason *string) int
+		UnwatchThread   func(childComplexity int, threadID uid.UID) int
+		WatchThread     func(childComplexity int, threadID uid.UID) int
+	}
+
+	NetBan struct {
+		CreatedAt func(childComplexity int) int
+		ExpiresAt func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Reason    func(childComplexity int) int
+		TargetID  func(childComplexity int) int
+	}
+
+	NetBanSlice struct {
+		Bans      func(childComplexity int) int
+		SliceInfo func(childComplexity int) int
 	}
 
 	NotiSlice struct {
@@ -84,11 +153,14 @@ type ComplexityRoot struct {
 	Post struct {
 		Author      func(childComplexity int) int
 		Blocked     func(childComplexity int) int
+		Bookmarked  func(childComplexity int) int
 		Content     func(childComplexity int) int
 		CreatedAt   func(childComplexity int) int
+		EditedAt    func(childComplexity int) int
 		ID          func(childComplexity int) int
 		QuotedCount func(childComplexity int) int
 		Quotes      func(childComplexity int) int
+		Revisions   func(childComplexity int) int
 	}
 
 	PostOutline struct {
@@ -104,13 +176,20 @@ type ComplexityRoot struct {
 
 	Query struct {
 		MainTags        func(childComplexity int) int
+		ModLog          func(childComplexity int, query entity.SliceQuery) int
+		NetBans         func(childComplexity int, query entity.SliceQuery) int
 		Notification    func(childComplexity int, query entity.SliceQuery) int
 		Post            func(childComplexity int, id uid.UID) int
 		Profile         func(childComplexity int) int
 		Recommended     func(childComplexity int) int
+		Reports         func(childComplexity int, status *entity.ReportStatus, query entity.SliceQuery) int
+		Search          func(childComplexity int, text string, tags []string, query entity.SliceQuery) int
+		Tag             func(childComplexity int, name string) int
 		Tags            func(childComplexity int, query *string, limit *int) int
 		Thread          func(childComplexity int, id uid.UID) int
-		ThreadSlice     func(childComplexity int, tags []string, query entity.SliceQuery) int
+		ThreadSlice     func(childComplexity int, tags []string, sort *entity.ThreadSort, query entity.SliceQuery) int
+		Timeline        func(childComplexity int, query entity.SliceQuery) int
+		TrendingTags    func(childComplexity int, window entity.TrendingWindow, limit *int) int
 		UnreadNotiCount func(childComplexity int) int
 	}
 
@@ -126,35 +205,83 @@ type ComplexityRoot struct {
 		Thread          func(childComplexity int) int
 	}
 
+	Report struct {
+		CreatedAt func(childComplexity int) int
+		HandledAt func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Post      func(childComplexity int) int
+		Reason    func(childComplexity int) int
+		Status    func(childComplexity int) int
+		Text      func(childComplexity int) int
+		Thread    func(childComplexity int) int
+	}
+
+	ReportSlice struct {
+		Reports   func(childComplexity int) int
+		SliceInfo func(childComplexity int) int
+	}
+
+	Revision struct {
+		Content   func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		Title     func(childComplexity int) int
+	}
+
+	SearchSlice struct {
+		Items     func(childComplexity int) int
+		SliceInfo func(childComplexity int) int
+	}
+
 	SliceInfo struct {
 		FirstCursor func(childComplexity int) int
 		HasNext     func(childComplexity int) int
 		LastCursor  func(childComplexity int) int
 	}
 
+	Subscription struct {
+		ThreadReplies func(childComplexity int, threadID uid.UID) int
+		UnreadNoti    func(childComplexity int) int
+	}
+
 	SystemNoti struct {
 		Content func(childComplexity int) int
 		Title   func(childComplexity int) int
 	}
 
 	Tag struct {
-		IsMain func(childComplexity int) int
-		Name   func(childComplexity int) int
+		ActiveAt        func(childComplexity int) int
+		Description     func(childComplexity int) int
+		IsMain          func(childComplexity int) int
+		Name            func(childComplexity int) int
+		Rules           func(childComplexity int) int
+		SubscriberCount func(childComplexity int) int
+		ThreadCount     func(childComplexity int) int
+	}
+
+	TagRole struct {
+		Role func(childComplexity int) int
+		Tag  func(childComplexity int) int
 	}
 
 	Thread struct {
-		Author     func(childComplexity int) int
-		Blocked    func(childComplexity int) int
-		Catalog    func(childComplexity int) int
-		Content    func(childComplexity int) int
-		CreatedAt  func(childComplexity int) int
-		ID         func(childComplexity int) int
-		Locked     func(childComplexity int) int
-		MainTag    func(childComplexity int) int
-		Replies    func(childComplexity int, query entity.SliceQuery) int
-		ReplyCount func(childComplexity int) int
-		SubTags    func(childComplexity int) int
-		Title      func(childComplexity int) int
+		Author      func(childComplexity int) int
+		Blocked     func(childComplexity int) int
+		Bookmarked  func(childComplexity int) int
+		Catalog     func(childComplexity int) int
+		Content     func(childComplexity int) int
+		CreatedAt   func(childComplexity int) int
+		EditedAt    func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Locked      func(childComplexity int) int
+		MainTag     func(childComplexity int) int
+		Pinned      func(childComplexity int) int
+		PinnedUntil func(childComplexity int) int
+		Replies     func(childComplexity int, query entity.SliceQuery) int
+		ReplyCount  func(childComplexity int) int
+		Revisions   func(childComplexity int) int
+		SubTags     func(childComplexity int) int
+		Title       func(childComplexity int) int
+		Watched     func(childComplexity int) int
 	}
 
 	ThreadCatalogItem struct {
@@ -175,53 +302,123 @@ type ComplexityRoot struct {
 		Threads   func(childComplexity int) int
 	}
 
+	UnreadNotiEvent struct {
+		Notification func(childComplexity int) int
+		UnreadCount  func(childComplexity int) int
+	}
+
 	User struct {
-		Email   func(childComplexity int) int
-		Name    func(childComplexity int) int
-		Posts   func(childComplexity int, query entity.SliceQuery) int
-		Role    func(childComplexity int) int
-		Tags    func(childComplexity int) int
-		Threads func(childComplexity int, query entity.SliceQuery) int
+		AutoWatch     func(childComplexity int) int
+		BanExpiresAt  func(childComplexity int) int
+		BanReason     func(childComplexity int) int
+		Bookmarks     func(childComplexity int, query entity.SliceQuery) int
+		Email         func(childComplexity int) int
+		HiddenThreads func(childComplexity int, query entity.SliceQuery) int
+		MutedTags     func(childComplexity int) int
+		Name          func(childComplexity int) int
+		Posts         func(childComplexity int, query entity.SliceQuery) int
+		Role          func(childComplexity int) int
+		TagRoles      func(childComplexity int) int
+		Tags          func(childComplexity int) int
+		Threads       func(childComplexity int, query entity.SliceQuery) int
 	}
 }
 
+type ModLogResolver interface {
+	Actor(ctx context.Context, obj *entity.ModLog) (*entity.User, error)
+}
 type MutationResolver interface {
+	Bookmark(ctx context.Context, threadID *uid.UID, postID *uid.UID) (bool, error)
+	Unbookmark(ctx context.Context, threadID *uid.UID, postID *uid.UID) (bool, error)
+	BanNetwork(ctx context.Context, postID *uid.UID, threadID *uid.UID, prefix *int, reason *string, duration *int) (*entity.NetBan, error)
+	UnbanNetwork(ctx context.Context, id uid.UID, reason *string) (bool, error)
 	PubPost(ctx context.Context, post entity.PostInput) (*entity.Post, error)
-	BlockPost(ctx context.Context, postID uid.UID) (*entity.Post, error)
+	EditPost(ctx context.Context, postID uid.UID, content string) (*entity.Post, error)
+	BlockPost(ctx context.Context, postID uid.UID, reason *string) (*entity.Post, error)
+	UnblockPost(ctx context.Context, postID uid.UID, reason *string) (*entity.Post, error)
+	ReportThread(ctx context.Context, threadID uid.UID, reason entity.ReportReason, text *string) (bool, error)
+	ReportPost(ctx context.Context, postID uid.UID, reason entity.ReportReason, text *string) (bool, error)
+	ResolveReport(ctx context.Context, reportID uid.UID, action *entity.ReportAction, reason *string) (*entity.Report, error)
+	DismissReport(ctx context.Context, reportID uid.UID) (*entity.Report, error)
+	AddMainTag(ctx context.Context, tag string) ([]string, error)
+	RenameMainTag(ctx context.Context, tag string, newName string) ([]string, error)
+	RetireMainTag(ctx context.Context, tag string) ([]string, error)
+	ReorderMainTags(ctx context.Context, tags []string) ([]string, error)
+	MergeTags(ctx context.Context, from string, into string) (bool, error)
+	EditTag(ctx context.Context, name string, description *string, rules *string) (*entity.Tag, error)
 	PubThread(ctx context.Context, thread entity.ThreadInput) (*entity.Thread, error)
-	LockThread(ctx context.Context, threadID uid.UID) (*entity.Thread, error)
-	BlockThread(ctx context.Context, threadID uid.UID) (*entity.Thread, error)
-	EditTags(ctx context.Context, threadID uid.UID, mainTag string, subTags []string) (*entity.Thread, error)
+	EditThread(ctx context.Context, threadID uid.UID, title *string, content string) (*entity.Thread, error)
+	WatchThread(ctx context.Context, threadID uid.UID) (*entity.Thread, error)
+	UnwatchThread(ctx context.Context, threadID uid.UID) (*entity.Thread, error)
+	HideThread(ctx context.Context, threadID uid.UID) (*entity.Thread, error)
+	UnhideThread(ctx context.Context, threadID uid.UID) (*entity.Thread, error)
+	LockThread(ctx context.Context, threadID uid.UID, reason *string) (*entity.Thread, error)
+	UnlockThread(ctx context.Context, threadID uid.UID, reason *string) (*entity.Thread, error)
+	BlockThread(ctx context.Context, threadID uid.UID, reason *string) (*entity.Thread, error)
+	UnblockThread(ctx context.Context, threadID uid.UID, reason *string) (*entity.Thread, error)
+	PinThread(ctx context.Context, threadID uid.UID, until *time.Time, reason *string) (*entity.Thread, error)
+	UnpinThread(ctx context.Context, threadID uid.UID, reason *string) (*entity.Thread, error)
+	EditTags(ctx context.Context, threadID uid.UID, mainTag string, subTags []string, reason *string) (*entity.Thread, error)
 	EmailAuth(ctx context.Context, email string, redirectTo *string) (bool, error)
 	SetName(ctx context.Context, name string) (*entity.User, error)
 	SyncTags(ctx context.Context, tags []string) (*entity.User, error)
 	AddSubbedTag(ctx context.Context, tag string) (*entity.User, error)
 	DelSubbedTag(ctx context.Context, tag string) (*entity.User, error)
-	BanUser(ctx context.Context, postID *uid.UID, threadID *uid.UID) (bool, error)
+	MuteTag(ctx context.Context, tag string) (*entity.User, error)
+	UnmuteTag(ctx context.Context, tag string) (*entity.User, error)
+	SetAutoWatch(ctx context.Context, enable bool) (*entity.User, error)
+	BanUser(ctx context.Context, postID *uid.UID, threadID *uid.UID, reason *string, duration *int) (bool, error)
+	UnbanUser(ctx context.Context, postID *uid.UID, threadID *uid.UID, reason *string) (bool, error)
+	SetUserRole(ctx context.Context, email *string, userID *uid.UID, role entity.Role, tag *string, reason *string) (*entity.User, error)
 }
 type PostResolver interface {
 	Quotes(ctx context.Context, obj *entity.Post) ([]*entity.Post, error)
 	QuotedCount(ctx context.Context, obj *entity.Post) (int, error)
+
+	Bookmarked(ctx context.Context, obj *entity.Post) (bool, error)
+
+	Revisions(ctx context.Context, obj *entity.Post) ([]*entity.Revision, error)
 }
 type QueryResolver interface {
+	ModLog(ctx context.Context, query entity.SliceQuery) (*entity.ModLogSlice, error)
+	NetBans(ctx context.Context, query entity.SliceQuery) (*entity.NetBanSlice, error)
 	UnreadNotiCount(ctx context.Context) (int, error)
 	Notification(ctx context.Context, query entity.SliceQuery) (*entity.NotiSlice, error)
 	Post(ctx context.Context, id uid.UID) (*entity.Post, error)
+	Reports(ctx context.Context, status *entity.ReportStatus, query entity.SliceQuery) (*entity.ReportSlice, error)
+	Search(ctx context.Context, text string, tags []string, query entity.SliceQuery) (*entity.SearchSlice, error)
 	MainTags(ctx context.Context) ([]string, error)
+	Tag(ctx context.Context, name string) (*entity.Tag, error)
 	Recommended(ctx context.Context) ([]string, error)
 	Tags(ctx context.Context, query *string, limit *int) ([]*entity.Tag, error)
-	ThreadSlice(ctx context.Context, tags []string, query entity.SliceQuery) (*entity.ThreadSlice, error)
+	TrendingTags(ctx context.Context, window entity.TrendingWindow, limit *int) ([]*entity.Tag, error)
+	ThreadSlice(ctx context.Context, tags []string, sort *entity.ThreadSort, query entity.SliceQuery) (*entity.ThreadSlice, error)
+	Timeline(ctx context.Context, query entity.SliceQuery) (*entity.ThreadSlice, error)
 	Thread(ctx context.Context, id uid.UID) (*entity.Thread, error)
 	Profile(ctx context.Context) (*entity.User, error)
 }
+type ReportResolver interface {
+	Thread(ctx context.Context, obj *entity.Report) (*entity.Thread, error)
+	Post(ctx context.Context, obj *entity.Report) (*entity.Post, error)
+}
+type SubscriptionResolver interface {
+	UnreadNoti(ctx context.Context) (<-chan *entity.UnreadNotiEvent, error)
+	ThreadReplies(ctx context.Context, threadID uid.UID) (<-chan *entity.Post, error)
+}
 type ThreadResolver interface {
 	Replies(ctx context.Context, obj *entity.Thread, query entity.SliceQuery) (*entity.PostSlice, error)
 	ReplyCount(ctx context.Context, obj *entity.Thread) (int, error)
 	Catalog(ctx context.Context, obj *entity.Thread) ([]*entity.ThreadCatalogItem, error)
+	Watched(ctx context.Context, obj *entity.Thread) (bool, error)
+	Bookmarked(ctx context.Context, obj *entity.Thread) (bool, error)
+
+	Revisions(ctx context.Context, obj *entity.Thread) ([]*entity.Revision, error)
 }
 type UserResolver interface {
 	Threads(ctx context.Context, obj *entity.User, query entity.SliceQuery) (*entity.ThreadSlice, error)
 	Posts(ctx context.Context, obj *entity.User, query entity.SliceQuery) (*entity.PostSlice, error)
+	Bookmarks(ctx context.Context, obj *entity.User, query entity.SliceQuery) (*entity.BookmarkSlice, error)
+	HiddenThreads(ctx context.Context, obj *entity.User, query entity.SliceQuery) (*entity.ThreadSlice, error)
 }
 
 type executableSchema struct {
@@ -253,6 +450,109 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Author.Author(childComplexity), true
 
+	case "Author.isOP":
+		if e.complexity.Author.IsOP == nil {
+			break
+		}
+
+		return e.complexity.Author.IsOP(childComplexity), true
+
+	case "BookmarkSlice.items":
+		if e.complexity.BookmarkSlice.Items == nil {
+			break
+		}
+
+		return e.complexity.BookmarkSlice.Items(childComplexity), true
+
+	case "BookmarkSlice.sliceInfo":
+		if e.complexity.BookmarkSlice.SliceInfo == nil {
+			break
+		}
+
+		return e.complexity.BookmarkSlice.SliceInfo(childComplexity), true
+
+	case "ModLog.action":
+		if e.complexity.ModLog.Action == nil {
+			break
+		}
+
+		return e.complexity.ModLog.Action(childComplexity), true
+
+	case "ModLog.actor":
+		if e.complexity.ModLog.Actor == nil {
+			break
+		}
+
+		return e.complexity.ModLog.Actor(childComplexity), true
+
+	case "ModLog.after":
+		if e.complexity.ModLog.After == nil {
+			break
+		}
+
+		return e.complexity.ModLog.After(childComplexity), true
+
+	case "ModLog.before":
+		if e.complexity.ModLog.Before == nil {
+			break
+		}
+
+		return e.complexity.ModLog.Before(childComplexity), true
+
+	case "ModLog.createdAt":
+		if e.complexity.ModLog.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.ModLog.CreatedAt(childComplexity), true
+
+	case "ModLog.id":
+		if e.complexity.ModLog.ID == nil {
+			break
+		}
+
+		return e.complexity.ModLog.ID(childComplexity), true
+
+	case "ModLog.reason":
+		if e.complexity.ModLog.Reason == nil {
+			break
+		}
+
+		return e.complexity.ModLog.Reason(childComplexity), true
+
+	case "ModLog.targetId":
+		if e.complexity.ModLog.TargetID == nil {
+			break
+		}
+
+		return e.complexity.ModLog.TargetID(childComplexity), true
+
+	case "ModLogSlice.logs":
+		if e.complexity.ModLogSlice.Logs == nil {
+			break
+		}
+
+		return e.complexity.ModLogSlice.Logs(childComplexity), true
+
+	case "ModLogSlice.sliceInfo":
+		if e.complexity.ModLogSlice.SliceInfo == nil {
+			break
+		}
+
+		return e.complexity.ModLogSlice.SliceInfo(childComplexity), true
+
+	case "Mutation.addMainTag":
+		if e.complexity.Mutation.AddMainTag == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addMainTag_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddMainTag(childComplexity, args["tag"].(string)), true
+
 	case "Mutation.addSubbedTag":
 		if e.complexity.Mutation.AddSubbedTag == nil {
 			break
@@ -265,6 +565,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.AddSubbedTag(childComplexity, args["tag"].(string)), true
 
+	case "Mutation.banNetwork":
+		if e.complexity.Mutation.BanNetwork == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_banNetwork_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.BanNetwork(childComplexity, args["postId"].(*uid.UID), args["threadId"].(*uid.UID), args["prefix"].(*int), args["reason"].(*string), args["duration"].(*int)), true
+
 	case "Mutation.banUser":
 		if e.complexity.Mutation.BanUser == nil {
 			break
@@ -275,7 +587,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.BanUser(childComplexity, args["postId"].(*uid.UID), args["threadId"].(*uid.UID)), true
+		return e.complexity.Mutation.BanUser(childComplexity, args["postId"].(*uid.UID), args["threadId"].(*uid.UID), args["reason"].(*string), args["duration"].(*int)), true
 
 	case "Mutation.blockPost":
 		if e.complexity.Mutation.BlockPost == nil {
@@ -287,7 +599,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.BlockPost(childComplexity, args["postId"].(uid.UID)), true
+		return e.complexity.Mutation.BlockPost(childComplexity, args["postId"].(uid.UID), args["reason"].(*string)), true
 
 	case "Mutation.blockThread":
 		if e.complexity.Mutation.BlockThread == nil {
@@ -299,7 +611,19 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.BlockThread(childComplexity, args["threadId"].(uid.UID)), true
+		return e.complexity.Mutation.BlockThread(childComplexity, args["threadId"].(uid.UID), args["reason"].(*string)), true
+
+	case "Mutation.bookmark":
+		if e.complexity.Mutation.Bookmark == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_bookmark_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Bookmark(childComplexity, args["threadId"].(*uid.UID), args["postId"].(*uid.UID)), true
 
 	case "Mutation.delSubbedTag":
 		if e.complexity.Mutation.DelSubbedTag == nil {
@@ -313,1356 +637,7006 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.DelSubbedTag(childComplexity, args["tag"].(string)), true
 
-	case "Mutation.editTags":
-		if e.complexity.Mutation.EditTags == nil {
+	case "Mutation.dismissReport":
+		if e.complexity.Mutation.DismissReport == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_editTags_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_dismissReport_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.EditTags(childComplexity, args["threadId"].(uid.UID), args["mainTag"].(string), args["subTags"].([]string)), true
+		return e.complexity.Mutation.DismissReport(childComplexity, args["reportId"].(uid.UID)), true
 
-	case "Mutation.emailAuth":
-		if e.complexity.Mutation.EmailAuth == nil {
+	case "Mutation.editPost":
+		if e.complexity.Mutation.EditPost == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_emailAuth_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_editPost_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.EmailAuth(childComplexity, args["email"].(string), args["redirectTo"].(*string)), true
+		return e.complexity.Mutation.EditPost(childComplexity, args["postId"].(uid.UID), args["content"].(string)), true
 
-	case "Mutation.lockThread":
-		if e.complexity.Mutation.LockThread == nil {
+	case "Mutation.editTag":
+		if e.complexity.Mutation.EditTag == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_lockThread_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_editTag_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.LockThread(childComplexity, args["threadId"].(uid.UID)), true
+		return e.complexity.Mutation.EditTag(childComplexity, args["name"].(string), args["description"].(*string), args["rules"].(*string)), true
 
-	case "Mutation.pubPost":
-		if e.complexity.Mutation.PubPost == nil {
+	case "Mutation.editTags":
+		if e.complexity.Mutation.EditTags == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_pubPost_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_editTags_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.PubPost(childComplexity, args["post"].(entity.PostInput)), true
+		return e.complexity.Mutation.EditTags(childComplexity, args["threadId"].(uid.UID), args["mainTag"].(string), args["subTags"].([]string), args["reason"].(*string)), true
 
-	case "Mutation.pubThread":
-		if e.complexity.Mutation.PubThread == nil {
+	case "Mutation.editThread":
+		if e.complexity.Mutation.EditThread == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_pubThread_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_editThread_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.PubThread(childComplexity, args["thread"].(entity.ThreadInput)), true
+		return e.complexity.Mutation.EditThread(childComplexity, args["threadId"].(uid.UID), args["title"].(*string), args["content"].(string)), true
 
-	case "Mutation.setName":
-		if e.complexity.Mutation.SetName == nil {
+	case "Mutation.emailAuth":
+		if e.complexity.Mutation.EmailAuth == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_setName_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_emailAuth_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.SetName(childComplexity, args["name"].(string)), true
+		return e.complexity.Mutation.EmailAuth(childComplexity, args["email"].(string), args["redirectTo"].(*string)), true
 
-	case "Mutation.syncTags":
-		if e.complexity.Mutation.SyncTags == nil {
+	case "Mutation.hideThread":
+		if e.complexity.Mutation.HideThread == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_syncTags_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_hideThread_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.SyncTags(childComplexity, args["tags"].([]string)), true
+		return e.complexity.Mutation.HideThread(childComplexity, args["threadId"].(uid.UID)), true
 
-	case "NotiSlice.notifications":
-		if e.complexity.NotiSlice.Notifications == nil {
+	case "Mutation.lockThread":
+		if e.complexity.Mutation.LockThread == nil {
 			break
 		}
 
-		return e.complexity.NotiSlice.Notifications(childComplexity), true
-
-	case "NotiSlice.sliceInfo":
-		if e.complexity.NotiSlice.SliceInfo == nil {
-			break
+		args, err := ec.field_Mutation_lockThread_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.NotiSlice.SliceInfo(childComplexity), true
+		return e.complexity.Mutation.LockThread(childComplexity, args["threadId"].(uid.UID), args["reason"].(*string)), true
 
-	case "Notification.content":
-		if e.complexity.Notification.Content == nil {
+	case "Mutation.mergeTags":
+		if e.complexity.Mutation.MergeTags == nil {
 			break
 		}
 
-		return e.complexity.Notification.Content(childComplexity), true
-
-	case "Notification.eventTime":
-		if e.complexity.Notification.EventTime == nil {
-			break
+		args, err := ec.field_Mutation_mergeTags_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.Notification.EventTime(childComplexity), true
+		return e.complexity.Mutation.MergeTags(childComplexity, args["from"].(string), args["into"].(string)), true
 
-	case "Notification.hasRead":
-		if e.complexity.Notification.HasRead == nil {
+	case "Mutation.muteTag":
+		if e.complexity.Mutation.MuteTag == nil {
 			break
 		}
 
-		return e.complexity.Notification.HasRead(childComplexity), true
-
-	case "Notification.type":
-		if e.complexity.Notification.Type == nil {
-			break
+		args, err := ec.field_Mutation_muteTag_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.Notification.Type(childComplexity), true
+		return e.complexity.Mutation.MuteTag(childComplexity, args["tag"].(string)), true
 
-	case "Post.author":
-		if e.complexity.Post.Author == nil {
+	case "Mutation.pinThread":
+		if e.complexity.Mutation.PinThread == nil {
 			break
 		}
 
-		return e.complexity.Post.Author(childComplexity), true
-
-	case "Post.blocked":
-		if e.complexity.Post.Blocked == nil {
-			break
+		args, err := ec.field_Mutation_pinThread_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.Post.Blocked(childComplexity), true
+		return e.complexity.Mutation.PinThread(childComplexity, args["threadId"].(uid.UID), args["until"].(*time.Time), args["reason"].(*string)), true
 
-	case "Post.content":
-		if e.complexity.Post.Content == nil {
+	case "Mutation.pubPost":
+		if e.complexity.Mutation.PubPost == nil {
 			break
 		}
 
-		return e.complexity.Post.Content(childComplexity), true
-
-	case "Post.createdAt":
-		if e.complexity.Post.CreatedAt == nil {
-			break
+		args, err := ec.field_Mutation_pubPost_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.Post.CreatedAt(childComplexity), true
+		return e.complexity.Mutation.PubPost(childComplexity, args["post"].(entity.PostInput)), true
 
-	case "Post.id":
-		if e.complexity.Post.ID == nil {
+	case "Mutation.pubThread":
+		if e.complexity.Mutation.PubThread == nil {
 			break
 		}
 
-		return e.complexity.Post.ID(childComplexity), true
-
-	case "Post.quotedCount":
-		if e.complexity.Post.QuotedCount == nil {
-			break
+		args, err := ec.field_Mutation_pubThread_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.Post.QuotedCount(childComplexity), true
+		return e.complexity.Mutation.PubThread(childComplexity, args["thread"].(entity.ThreadInput)), true
 
-	case "Post.quotes":
-		if e.complexity.Post.Quotes == nil {
+	case "Mutation.renameMainTag":
+		if e.complexity.Mutation.RenameMainTag == nil {
 			break
 		}
 
-		return e.complexity.Post.Quotes(childComplexity), true
-
-	case "PostOutline.author":
-		if e.complexity.PostOutline.Author == nil {
-			break
+		args, err := ec.field_Mutation_renameMainTag_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PostOutline.Author(childComplexity), true
+		return e.complexity.Mutation.RenameMainTag(childComplexity, args["tag"].(string), args["newName"].(string)), true
 
-	case "PostOutline.content":
-		if e.complexity.PostOutline.Content == nil {
+	case "Mutation.reorderMainTags":
+		if e.complexity.Mutation.ReorderMainTags == nil {
 			break
 		}
 
-		return e.complexity.PostOutline.Content(childComplexity), true
-
-	case "PostOutline.id":
-		if e.complexity.PostOutline.ID == nil {
-			break
+		args, err := ec.field_Mutation_reorderMainTags_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PostOutline.ID(childComplexity), true
+		return e.complexity.Mutation.ReorderMainTags(childComplexity, args["tags"].([]string)), true
 
-	case "PostSlice.posts":
-		if e.complexity.PostSlice.Posts == nil {
+	case "Mutation.reportPost":
+		if e.complexity.Mutation.ReportPost == nil {
 			break
 		}
 
-		return e.complexity.PostSlice.Posts(childComplexity), true
-
-	case "PostSlice.sliceInfo":
-		if e.complexity.PostSlice.SliceInfo == nil {
-			break
+		args, err := ec.field_Mutation_reportPost_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PostSlice.SliceInfo(childComplexity), true
+		return e.complexity.Mutation.ReportPost(childComplexity, args["postId"].(uid.UID), args["reason"].(entity.ReportReason), args["text"].(*string)), true
 
-	case "Query.mainTags":
-		if e.complexity.Query.MainTags == nil {
+	case "Mutation.reportThread":
+		if e.complexity.Mutation.ReportThread == nil {
 			break
 		}
 
-		return e.complexity.Query.MainTags(childComplexity), true
+		args, err := ec.field_Mutation_reportThread_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Query.notification":
-		if e.complexity.Query.Notification == nil {
+		return e.complexity.Mutation.ReportThread(childComplexity, args["threadId"].(uid.UID), args["reason"].(entity.ReportReason), args["text"].(*string)), true
+
+	case "Mutation.resolveReport":
+		if e.complexity.Mutation.ResolveReport == nil {
 			break
 		}
 
-		args, err := ec.field_Query_notification_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_resolveReport_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Notification(childComplexity, args["query"].(entity.SliceQuery)), true
+		return e.complexity.Mutation.ResolveReport(childComplexity, args["reportId"].(uid.UID), args["action"].(*entity.ReportAction), args["reason"].(*string)), true
 
-	case "Query.post":
-		if e.complexity.Query.Post == nil {
+	case "Mutation.retireMainTag":
+		if e.complexity.Mutation.RetireMainTag == nil {
 			break
 		}
 
-		args, err := ec.field_Query_post_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_retireMainTag_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Post(childComplexity, args["id"].(uid.UID)), true
+		return e.complexity.Mutation.RetireMainTag(childComplexity, args["tag"].(string)), true
 
-	case "Query.profile":
-		if e.complexity.Query.Profile == nil {
+	case "Mutation.setAutoWatch":
+		if e.complexity.Mutation.SetAutoWatch == nil {
 			break
 		}
 
-		return e.complexity.Query.Profile(childComplexity), true
-
-	case "Query.recommended":
-		if e.complexity.Query.Recommended == nil {
-			break
+		args, err := ec.field_Mutation_setAutoWatch_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.Query.Recommended(childComplexity), true
+		return e.complexity.Mutation.SetAutoWatch(childComplexity, args["enable"].(bool)), true
 
-	case "Query.tags":
-		if e.complexity.Query.Tags == nil {
+	case "Mutation.setName":
+		if e.complexity.Mutation.SetName == nil {
 			break
 		}
 
-		args, err := ec.field_Query_tags_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_setName_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Tags(childComplexity, args["query"].(*string), args["limit"].(*int)), true
+		return e.complexity.Mutation.SetName(childComplexity, args["name"].(string)), true
 
-	case "Query.thread":
-		if e.complexity.Query.Thread == nil {
+	case "Mutation.setUserRole":
+		if e.complexity.Mutation.SetUserRole == nil {
 			break
 		}
 
-		args, err := ec.field_Query_thread_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_setUserRole_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Thread(childComplexity, args["id"].(uid.UID)), true
+		return e.complexity.Mutation.SetUserRole(childComplexity, args["email"].(*string), args["userId"].(*uid.UID), args["role"].(entity.Role), args["tag"].(*string), args["reason"].(*string)), true
 
-	case "Query.threadSlice":
-		if e.complexity.Query.ThreadSlice == nil {
+	case "Mutation.syncTags":
+		if e.complexity.Mutation.SyncTags == nil {
 			break
 		}
 
-		args, err := ec.field_Query_threadSlice_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_syncTags_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.ThreadSlice(childComplexity, args["tags"].([]string), args["query"].(entity.SliceQuery)), true
+		return e.complexity.Mutation.SyncTags(childComplexity, args["tags"].([]string)), true
 
-	case "Query.unreadNotiCount":
-		if e.complexity.Query.UnreadNotiCount == nil {
+	case "Mutation.unbanNetwork":
+		if e.complexity.Mutation.UnbanNetwork == nil {
 			break
 		}
 
-		return e.complexity.Query.UnreadNotiCount(childComplexity), true
+		args, err := ec.field_Mutation_unbanNetwork_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "QuotedNoti.post":
-		if e.complexity.QuotedNoti.Post == nil {
+		return e.complexity.Mutation.UnbanNetwork(childComplexity, args["id"].(uid.UID), args["reason"].(*string)), true
+
+	case "Mutation.unbanUser":
+		if e.complexity.Mutation.UnbanUser == nil {
 			break
 		}
 
-		return e.complexity.QuotedNoti.Post(childComplexity), true
+		args, err := ec.field_Mutation_unbanUser_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "QuotedNoti.quotedPost":
-		if e.complexity.QuotedNoti.QuotedPost == nil {
+		return e.complexity.Mutation.UnbanUser(childComplexity, args["postId"].(*uid.UID), args["threadId"].(*uid.UID), args["reason"].(*string)), true
+
+	case "Mutation.unblockPost":
+		if e.complexity.Mutation.UnblockPost == nil {
 			break
 		}
 
-		return e.complexity.QuotedNoti.QuotedPost(childComplexity), true
+		args, err := ec.field_Mutation_unblockPost_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "QuotedNoti.threadId":
-		if e.complexity.QuotedNoti.ThreadID == nil {
+		return e.complexity.Mutation.UnblockPost(childComplexity, args["postId"].(uid.UID), args["reason"].(*string)), true
+
+	case "Mutation.unblockThread":
+		if e.complexity.Mutation.UnblockThread == nil {
 			break
 		}
 
-		return e.complexity.QuotedNoti.ThreadID(childComplexity), true
+		args, err := ec.field_Mutation_unblockThread_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "RepliedNoti.firstReplyId":
-		if e.complexity.RepliedNoti.FirstReplyID == nil {
+		return e.complexity.Mutation.UnblockThread(childComplexity, args["threadId"].(uid.UID), args["reason"].(*string)), true
+
+	case "Mutation.unbookmark":
+		if e.complexity.Mutation.Unbookmark == nil {
 			break
 		}
 
-		return e.complexity.RepliedNoti.FirstReplyID(childComplexity), true
+		args, err := ec.field_Mutation_unbookmark_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "RepliedNoti.newRepliesCount":
-		if e.complexity.RepliedNoti.NewRepliesCount == nil {
+		return e.complexity.Mutation.Unbookmark(childComplexity, args["threadId"].(*uid.UID), args["postId"].(*uid.UID)), true
+
+	case "Mutation.unhideThread":
+		if e.complexity.Mutation.UnhideThread == nil {
 			break
 		}
 
-		return e.complexity.RepliedNoti.NewRepliesCount(childComplexity), true
+		args, err := ec.field_Mutation_unhideThread_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "RepliedNoti.thread":
-		if e.complexity.RepliedNoti.Thread == nil {
+		return e.complexity.Mutation.UnhideThread(childComplexity, args["threadId"].(uid.UID)), true
+
+	case "Mutation.unlockThread":
+		if e.complexity.Mutation.UnlockThread == nil {
 			break
 		}
 
-		return e.complexity.RepliedNoti.Thread(childComplexity), true
+		args, err := ec.field_Mutation_unlockThread_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "SliceInfo.firstCursor":
-		if e.complexity.SliceInfo.FirstCursor == nil {
+		return e.complexity.Mutation.UnlockThread(childComplexity, args["threadId"].(uid.UID), args["reason"].(*string)), true
+
+	case "Mutation.unmuteTag":
+		if e.complexity.Mutation.UnmuteTag == nil {
 			break
 		}
 
-		return e.complexity.SliceInfo.FirstCursor(childComplexity), true
+		args, err := ec.field_Mutation_unmuteTag_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "SliceInfo.hasNext":
-		if e.complexity.SliceInfo.HasNext == nil {
+		return e.complexity.Mutation.UnmuteTag(childComplexity, args["tag"].(string)), true
+
+	case "Mutation.unpinThread":
+		if e.complexity.Mutation.UnpinThread == nil {
 			break
 		}
 
-		return e.complexity.SliceInfo.HasNext(childComplexity), true
+		args, err := ec.field_Mutation_unpinThread_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "SliceInfo.lastCursor":
-		if e.complexity.SliceInfo.LastCursor == nil {
+		return e.complexity.Mutation.UnpinThread(childComplexity, args["threadId"].(uid.UID), args["reason"].(*string)), true
+
+	case "Mutation.unwatchThread":
+		if e.complexity.Mutation.UnwatchThread == nil {
 			break
 		}
 
-		return e.complexity.SliceInfo.LastCursor(childComplexity), true
+		args, err := ec.field_Mutation_unwatchThread_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "SystemNoti.content":
-		if e.complexity.SystemNoti.Content == nil {
+		return e.complexity.Mutation.UnwatchThread(childComplexity, args["threadId"].(uid.UID)), true
+
+	case "Mutation.watchThread":
+		if e.complexity.Mutation.WatchThread == nil {
 			break
 		}
 
-		return e.complexity.SystemNoti.Content(childComplexity), true
+		args, err := ec.field_Mutation_watchThread_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "SystemNoti.title":
-		if e.complexity.SystemNoti.Title == nil {
+		return e.complexity.Mutation.WatchThread(childComplexity, args["threadId"].(uid.UID)), true
+
+	case "NetBan.createdAt":
+		if e.complexity.NetBan.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.SystemNoti.Title(childComplexity), true
+		return e.complexity.NetBan.CreatedAt(childComplexity), true
 
-	case "Tag.isMain":
-		if e.complexity.Tag.IsMain == nil {
+	case "NetBan.expiresAt":
+		if e.complexity.NetBan.ExpiresAt == nil {
 			break
 		}
 
-		return e.complexity.Tag.IsMain(childComplexity), true
+		return e.complexity.NetBan.ExpiresAt(childComplexity), true
 
-	case "Tag.name":
-		if e.complexity.Tag.Name == nil {
+	case "NetBan.id":
+		if e.complexity.NetBan.ID == nil {
 			break
 		}
 
-		return e.complexity.Tag.Name(childComplexity), true
+		return e.complexity.NetBan.ID(childComplexity), true
 
-	case "Thread.author":
-		if e.complexity.Thread.Author == nil {
+	case "NetBan.reason":
+		if e.complexity.NetBan.Reason == nil {
 			break
 		}
 
-		return e.complexity.Thread.Author(childComplexity), true
+		return e.complexity.NetBan.Reason(childComplexity), true
 
-	case "Thread.blocked":
-		if e.complexity.Thread.Blocked == nil {
+	case "NetBan.targetId":
+		if e.complexity.NetBan.TargetID == nil {
 			break
 		}
 
-		return e.complexity.Thread.Blocked(childComplexity), true
+		return e.complexity.NetBan.TargetID(childComplexity), true
 
-	case "Thread.catalog":
-		if e.complexity.Thread.Catalog == nil {
+	case "NetBanSlice.bans":
+		if e.complexity.NetBanSlice.Bans == nil {
 			break
 		}
 
-		return e.complexity.Thread.Catalog(childComplexity), true
+		return e.complexity.NetBanSlice.Bans(childComplexity), true
 
-	case "Thread.content":
-		if e.complexity.Thread.Content == nil {
+	case "NetBanSlice.sliceInfo":
+		if e.complexity.NetBanSlice.SliceInfo == nil {
 			break
 		}
 
-		return e.complexity.Thread.Content(childComplexity), true
+		return e.complexity.NetBanSlice.SliceInfo(childComplexity), true
 
-	case "Thread.createdAt":
-		if e.complexity.Thread.CreatedAt == nil {
+	case "NotiSlice.notifications":
+		if e.complexity.NotiSlice.Notifications == nil {
 			break
 		}
 
-		return e.complexity.Thread.CreatedAt(childComplexity), true
+		return e.complexity.NotiSlice.Notifications(childComplexity), true
 
-	case "Thread.id":
-		if e.complexity.Thread.ID == nil {
+	case "NotiSlice.sliceInfo":
+		if e.complexity.NotiSlice.SliceInfo == nil {
 			break
 		}
 
-		return e.complexity.Thread.ID(childComplexity), true
+		return e.complexity.NotiSlice.SliceInfo(childComplexity), true
 
-	case "Thread.locked":
-		if e.complexity.Thread.Locked == nil {
+	case "Notification.content":
+		if e.complexity.Notification.Content == nil {
 			break
 		}
 
-		return e.complexity.Thread.Locked(childComplexity), true
+		return e.complexity.Notification.Content(childComplexity), true
 
-	case "Thread.mainTag":
-		if e.complexity.Thread.MainTag == nil {
+	case "Notification.eventTime":
+		if e.complexity.Notification.EventTime == nil {
 			break
 		}
 
-		return e.complexity.Thread.MainTag(childComplexity), true
+		return e.complexity.Notification.EventTime(childComplexity), true
 
-	case "Thread.replies":
-		if e.complexity.Thread.Replies == nil {
+	case "Notification.hasRead":
+		if e.complexity.Notification.HasRead == nil {
 			break
 		}
 
-		args, err := ec.field_Thread_replies_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Notification.HasRead(childComplexity), true
+
+	case "Notification.type":
+		if e.complexity.Notification.Type == nil {
+			break
 		}
 
-		return e.complexity.Thread.Replies(childComplexity, args["query"].(entity.SliceQuery)), true
+		return e.complexity.Notification.Type(childComplexity), true
 
-	case "Thread.replyCount":
-		if e.complexity.Thread.ReplyCount == nil {
+	case "Post.author":
+		if e.complexity.Post.Author == nil {
 			break
 		}
 
-		return e.complexity.Thread.ReplyCount(childComplexity), true
+		return e.complexity.Post.Author(childComplexity), true
 
-	case "Thread.subTags":
-		if e.complexity.Thread.SubTags == nil {
+	case "Post.blocked":
+		if e.complexity.Post.Blocked == nil {
 			break
 		}
 
-		return e.complexity.Thread.SubTags(childComplexity), true
+		return e.complexity.Post.Blocked(childComplexity), true
 
-	case "Thread.title":
-		if e.complexity.Thread.Title == nil {
+	case "Post.bookmarked":
+		if e.complexity.Post.Bookmarked == nil {
 			break
 		}
 
-		return e.complexity.Thread.Title(childComplexity), true
+		return e.complexity.Post.Bookmarked(childComplexity), true
 
-	case "ThreadCatalogItem.createdAt":
-		if e.complexity.ThreadCatalogItem.CreatedAt == nil {
+	case "Post.content":
+		if e.complexity.Post.Content == nil {
 			break
 		}
 
-		return e.complexity.ThreadCatalogItem.CreatedAt(childComplexity), true
+		return e.complexity.Post.Content(childComplexity), true
 
-	case "ThreadCatalogItem.postId":
-		if e.complexity.ThreadCatalogItem.PostID == nil {
+	case "Post.createdAt":
+		if e.complexity.Post.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.ThreadCatalogItem.PostID(childComplexity), true
+		return e.complexity.Post.CreatedAt(childComplexity), true
 
-	case "ThreadOutline.content":
-		if e.complexity.ThreadOutline.Content == nil {
+	case "Post.editedAt":
+		if e.complexity.Post.EditedAt == nil {
 			break
 		}
 
-		return e.complexity.ThreadOutline.Content(childComplexity), true
+		return e.complexity.Post.EditedAt(childComplexity), true
 
-	case "ThreadOutline.id":
-		if e.complexity.ThreadOutline.ID == nil {
+	case "Post.id":
+		if e.complexity.Post.ID == nil {
 			break
 		}
 
-		return e.complexity.ThreadOutline.ID(childComplexity), true
+		return e.complexity.Post.ID(childComplexity), true
 
-	case "ThreadOutline.mainTag":
-		if e.complexity.ThreadOutline.MainTag == nil {
+	case "Post.quotedCount":
+		if e.complexity.Post.QuotedCount == nil {
 			break
 		}
 
-		return e.complexity.ThreadOutline.MainTag(childComplexity), true
+		return e.complexity.Post.QuotedCount(childComplexity), true
 
-	case "ThreadOutline.subTags":
-		if e.complexity.ThreadOutline.SubTags == nil {
+	case "Post.quotes":
+		if e.complexity.Post.Quotes == nil {
 			break
 		}
 
-		return e.complexity.ThreadOutline.SubTags(childComplexity), true
+		return e.complexity.Post.Quotes(childComplexity), true
 
-	case "ThreadOutline.title":
-		if e.complexity.ThreadOutline.Title == nil {
+	case "Post.revisions":
+		if e.complexity.Post.Revisions == nil {
 			break
 		}
 
-		return e.complexity.ThreadOutline.Title(childComplexity), true
+		return e.complexity.Post.Revisions(childComplexity), true
 
-	case "ThreadSlice.sliceInfo":
-		if e.complexity.ThreadSlice.SliceInfo == nil {
+	case "PostOutline.author":
+		if e.complexity.PostOutline.Author == nil {
 			break
 		}
 
-		return e.complexity.ThreadSlice.SliceInfo(childComplexity), true
+		return e.complexity.PostOutline.Author(childComplexity), true
 
-	case "ThreadSlice.threads":
-		if e.complexity.ThreadSlice.Threads == nil {
+	case "PostOutline.content":
+		if e.complexity.PostOutline.Content == nil {
 			break
 		}
 
-		return e.complexity.ThreadSlice.Threads(childComplexity), true
+		return e.complexity.PostOutline.Content(childComplexity), true
 
-	case "User.email":
-		if e.complexity.User.Email == nil {
+	case "PostOutline.id":
+		if e.complexity.PostOutline.ID == nil {
 			break
 		}
 
-		return e.complexity.User.Email(childComplexity), true
+		return e.complexity.PostOutline.ID(childComplexity), true
 
-	case "User.name":
-		if e.complexity.User.Name == nil {
+	case "PostSlice.posts":
+		if e.complexity.PostSlice.Posts == nil {
 			break
 		}
 
-		return e.complexity.User.Name(childComplexity), true
+		return e.complexity.PostSlice.Posts(childComplexity), true
 
-	case "User.posts":
-		if e.complexity.User.Posts == nil {
+	case "PostSlice.sliceInfo":
+		if e.complexity.PostSlice.SliceInfo == nil {
 			break
 		}
 
-		args, err := ec.field_User_posts_args(context.TODO(), rawArgs)
+		return e.complexity.PostSlice.SliceInfo(childComplexity), true
+
+	case "Query.mainTags":
+		if e.complexity.Query.MainTags == nil {
+			break
+		}
+
+		return e.complexity.Query.MainTags(childComplexity), true
+
+	case "Query.modLog":
+		if e.complexity.Query.ModLog == nil {
+			break
+		}
+
+		args, err := ec.field_Query_modLog_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.User.Posts(childComplexity, args["query"].(entity.SliceQuery)), true
+		return e.complexity.Query.ModLog(childComplexity, args["query"].(entity.SliceQuery)), true
 
-	case "User.role":
-		if e.complexity.User.Role == nil {
+	case "Query.netBans":
+		if e.complexity.Query.NetBans == nil {
 			break
 		}
 
-		return e.complexity.User.Role(childComplexity), true
+		args, err := ec.field_Query_netBans_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "User.tags":
-		if e.complexity.User.Tags == nil {
+		return e.complexity.Query.NetBans(childComplexity, args["query"].(entity.SliceQuery)), true
+
+	case "Query.notification":
+		if e.complexity.Query.Notification == nil {
 			break
 		}
 
-		return e.complexity.User.Tags(childComplexity), true
+		args, err := ec.field_Query_notification_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "User.threads":
-		if e.complexity.User.Threads == nil {
+		return e.complexity.Query.Notification(childComplexity, args["query"].(entity.SliceQuery)), true
+
+	case "Query.post":
+		if e.complexity.Query.Post == nil {
 			break
 		}
 
-		args, err := ec.field_User_threads_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_post_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.User.Threads(childComplexity, args["query"].(entity.SliceQuery)), true
+		return e.complexity.Query.Post(childComplexity, args["id"].(uid.UID)), true
 
-	}
-	return 0, false
-}
+	case "Query.profile":
+		if e.complexity.Query.Profile == nil {
+			break
+		}
 
-func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
-	rc := graphql.GetOperationContext(ctx)
-	ec := executionContext{rc, e}
-	first := true
+		return e.complexity.Query.Profile(childComplexity), true
 
-	switch rc.Operation.Operation {
-	case ast.Query:
-		return func(ctx context.Context) *graphql.Response {
-			if !first {
-				return nil
-			}
-			first = false
-			data := ec._Query(ctx, rc.Operation.SelectionSet)
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
+	case "Query.recommended":
+		if e.complexity.Query.Recommended == nil {
+			break
+		}
 
-			return &graphql.Response{
-				Data: buf.Bytes(),
-			}
+		return e.complexity.Query.Recommended(childComplexity), true
+
+	case "Query.reports":
+		if e.complexity.Query.Reports == nil {
+			break
 		}
-	case ast.Mutation:
-		return func(ctx context.Context) *graphql.Response {
-			if !first {
-				return nil
-			}
-			first = false
-			data := ec._Mutation(ctx, rc.Operation.SelectionSet)
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
 
-			return &graphql.Response{
-				Data: buf.Bytes(),
-			}
+		args, err := ec.field_Query_reports_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-	default:
-		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
-	}
-}
+		return e.complexity.Query.Reports(childComplexity, args["status"].(*entity.ReportStatus), args["query"].(entity.SliceQuery)), true
 
-type executionContext struct {
-	*graphql.OperationContext
-	*executableSchema
-}
+	case "Query.search":
+		if e.complexity.Query.Search == nil {
+			break
+		}
 
-func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapSchema(parsedSchema), nil
-}
+		args, err := ec.field_Query_search_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapTypeFromDef(parsedSchema, parsedSchema.Types[name]), nil
-}
+		return e.complexity.Query.Search(childComplexity, args["text"].(string), args["tags"].([]string), args["query"].(entity.SliceQuery)), true
 
-var sources = []*ast.Source{
-	&ast.Source{Name: "schema/base.gql", Input: `scalar Time
+	case "Query.tag":
+		if e.complexity.Query.Tag == nil {
+			break
+		}
 
-""" UID type is a universal id in this service.
-It's base64-like string when displayed, 60-bit integer in storage."""
-scalar UID
+		args, err := ec.field_Query_tag_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-""" SliceInfo objects are generated by the server.
-Can be used in consecutive queries."""
-type SliceInfo {
-  firstCursor: String!
-  lastCursor: String!
-  """ If more results exist after lastCursor."""
-  hasNext: Boolean!
-}
+		return e.complexity.Query.Tag(childComplexity, args["name"].(string)), true
 
-""" SliceQuery object is for selecting specific 'slice' of an object to return.
-Affects the returning SliceInfo."""
-input SliceQuery {
-  """ Either this field or 'after' is required
-  An empty string means slice from the beginning."""
-  before: String
-  """ Either this field or 'before' is required.
-  An empty string means slice to the end."""
-  after: String
-  """ Set the amount of returned items."""
-  limit: Int!
-}
+	case "Query.tags":
+		if e.complexity.Query.Tags == nil {
+			break
+		}
 
-""" Author for thread and post """
-type Author {
-  """ Author is anonymous or not."""
-  anonymous: Boolean!
-  """ Author display name. It's UID format if anonymous, user's name otherwise. """
-  author: String!
-}
-`, BuiltIn: false},
-	&ast.Source{Name: "schema/notification.gql", Input: `extend type Query {
-  """ The count of unread notifications. """
-  unreadNotiCount: Int!
-  """ Notifications for current user. """
-  notification(query: SliceQuery!): NotiSlice!
-}
+		args, err := ec.field_Query_tags_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-enum NotiType {
-  system
-  replied
-  quoted
-}
+		return e.complexity.Query.Tags(childComplexity, args["query"].(*string), args["limit"].(*int)), true
 
-""" NotiSlice object is for selecting specific 'slice' of an object to return.
-Affects the returning SliceInfo. """
-type NotiSlice {
-  notifications: [Notification!]!
-  sliceInfo: SliceInfo!
-}
+	case "Query.thread":
+		if e.complexity.Query.Thread == nil {
+			break
+		}
 
-type Notification {
-  """ Type of Notification. "system", "replied" or "quoted"."""
-  type: NotiType!
-  """ Time when a notify event triggers. E.g. The time when a system event is
-  announced from the server."""
-  eventTime: Time!
-  """ The notification is read or not."""
-  hasRead: Boolean!
-  """ notification content for different type."""
-  content: NotiContent!
-}
+		args, err := ec.field_Query_thread_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-""" Union type of Notificatiion contents """
-union NotiContent = SystemNoti | RepliedNoti | QuotedNoti
+		return e.complexity.Query.Thread(childComplexity, args["id"].(uid.UID)), true
 
-""" Object describing contents of a system notification."""
-type SystemNoti {
-  """ Notification title."""
-  title: String!
-  """ Markdown formatted notification content."""
-  content: String!
-}
+	case "Query.threadSlice":
+		if e.complexity.Query.ThreadSlice == nil {
+			break
+		}
 
-""" Stripped version of Thread object."""
-type ThreadOutline {
-  """ thread.id """
-  id: UID!
-  """ thread.title """
-  title: String
-  """ Markdown formatted content."""
-  content: String!
-  mainTag: String!
-  subTags: [String!]!
-}
+		args, err := ec.field_Query_threadSlice_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-""" Stripped version of Post object."""
-type PostOutline {
-  """ post.id """
-  id: UID!
-  """ post.author """
-  author: Author!
-  """ Markdown formatted content."""
-  content: String!
-}
+		return e.complexity.Query.ThreadSlice(childComplexity, args["tags"].([]string), args["sort"].(*entity.ThreadSort), args["query"].(entity.SliceQuery)), true
 
-""" Object describing contents of a replied notification."""
-type RepliedNoti {
-  """ The Thread object that is replied."""
-  thread: ThreadOutline!
-  """ How much new replies since the last read of this notification."""
-  newRepliesCount: Int!
-  """ ID of the first reply since the last read of this notification."""
-  firstReplyId: UID!
-}
+	case "Query.timeline":
+		if e.complexity.Query.Timeline == nil {
+			break
+		}
 
-""" Object describing contents of a quoted notification."""
-type QuotedNoti {
-  """ ID of the Thread quoted."""
-  threadId: UID!
-  """ The Post object quoted."""
-  quotedPost: PostOutline!
-  """ The Post object that made this notification."""
-  post: PostOutline!
-}
-`, BuiltIn: false},
-	&ast.Source{Name: "schema/post.gql", Input: `extend type Query {
-  """ A post object."""
-  post(id: UID!): Post!
+		args, err := ec.field_Query_timeline_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Timeline(childComplexity, args["query"].(entity.SliceQuery)), true
+
+	case "Query.trendingTags":
+		if e.complexity.Query.TrendingTags == nil {
+			break
+		}
+
+		args, err := ec.field_Query_trendingTags_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.TrendingTags(childComplexity, args["window"].(entity.TrendingWindow), args["limit"].(*int)), true
+
+	case "Query.unreadNotiCount":
+		if e.complexity.Query.UnreadNotiCount == nil {
+			break
+		}
+
+		return e.complexity.Query.UnreadNotiCount(childComplexity), true
+
+	case "QuotedNoti.post":
+		if e.complexity.QuotedNoti.Post == nil {
+			break
+		}
+
+		return e.complexity.QuotedNoti.Post(childComplexity), true
+
+	case "QuotedNoti.quotedPost":
+		if e.complexity.QuotedNoti.QuotedPost == nil {
+			break
+		}
+
+		return e.complexity.QuotedNoti.QuotedPost(childComplexity), true
+
+	case "QuotedNoti.threadId":
+		if e.complexity.QuotedNoti.ThreadID == nil {
+			break
+		}
+
+		return e.complexity.QuotedNoti.ThreadID(childComplexity), true
+
+	case "RepliedNoti.firstReplyId":
+		if e.complexity.RepliedNoti.FirstReplyID == nil {
+			break
+		}
+
+		return e.complexity.RepliedNoti.FirstReplyID(childComplexity), true
+
+	case "RepliedNoti.newRepliesCount":
+		if e.complexity.RepliedNoti.NewRepliesCount == nil {
+			break
+		}
+
+		return e.complexity.RepliedNoti.NewRepliesCount(childComplexity), true
+
+	case "RepliedNoti.thread":
+		if e.complexity.RepliedNoti.Thread == nil {
+			break
+		}
+
+		return e.complexity.RepliedNoti.Thread(childComplexity), true
+
+	case "Report.createdAt":
+		if e.complexity.Report.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Report.CreatedAt(childComplexity), true
+
+	case "Report.handledAt":
+		if e.complexity.Report.HandledAt == nil {
+			break
+		}
+
+		return e.complexity.Report.HandledAt(childComplexity), true
+
+	case "Report.id":
+		if e.complexity.Report.ID == nil {
+			break
+		}
+
+		return e.complexity.Report.ID(childComplexity), true
+
+	case "Report.post":
+		if e.complexity.Report.Post == nil {
+			break
+		}
+
+		return e.complexity.Report.Post(childComplexity), true
+
+	case "Report.reason":
+		if e.complexity.Report.Reason == nil {
+			break
+		}
+
+		return e.complexity.Report.Reason(childComplexity), true
+
+	case "Report.status":
+		if e.complexity.Report.Status == nil {
+			break
+		}
+
+		return e.complexity.Report.Status(childComplexity), true
+
+	case "Report.text":
+		if e.complexity.Report.Text == nil {
+			break
+		}
+
+		return e.complexity.Report.Text(childComplexity), true
+
+	case "Report.thread":
+		if e.complexity.Report.Thread == nil {
+			break
+		}
+
+		return e.complexity.Report.Thread(childComplexity), true
+
+	case "ReportSlice.reports":
+		if e.complexity.ReportSlice.Reports == nil {
+			break
+		}
+
+		return e.complexity.ReportSlice.Reports(childComplexity), true
+
+	case "ReportSlice.sliceInfo":
+		if e.complexity.ReportSlice.SliceInfo == nil {
+			break
+		}
+
+		return e.complexity.ReportSlice.SliceInfo(childComplexity), true
+
+	case "Revision.content":
+		if e.complexity.Revision.Content == nil {
+			break
+		}
+
+		return e.complexity.Revision.Content(childComplexity), true
+
+	case "Revision.createdAt":
+		if e.complexity.Revision.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Revision.CreatedAt(childComplexity), true
+
+	case "Revision.title":
+		if e.complexity.Revision.Title == nil {
+			break
+		}
+
+		return e.complexity.Revision.Title(childComplexity), true
+
+	case "SearchSlice.items":
+		if e.complexity.SearchSlice.Items == nil {
+			break
+		}
+
+		return e.complexity.SearchSlice.Items(childComplexity), true
+
+	case "SearchSlice.sliceInfo":
+		if e.complexity.SearchSlice.SliceInfo == nil {
+			break
+		}
+
+		return e.complexity.SearchSlice.SliceInfo(childComplexity), true
+
+	case "SliceInfo.firstCursor":
+		if e.complexity.SliceInfo.FirstCursor == nil {
+			break
+		}
+
+		return e.complexity.SliceInfo.FirstCursor(childComplexity), true
+
+	case "SliceInfo.hasNext":
+		if e.complexity.SliceInfo.HasNext == nil {
+			break
+		}
+
+		return e.complexity.SliceInfo.HasNext(childComplexity), true
+
+	case "SliceInfo.lastCursor":
+		if e.complexity.SliceInfo.LastCursor == nil {
+			break
+		}
+
+		return e.complexity.SliceInfo.LastCursor(childComplexity), true
+
+	case "Subscription.threadReplies":
+		if e.complexity.Subscription.ThreadReplies == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_threadReplies_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.ThreadReplies(childComplexity, args["threadId"].(uid.UID)), true
+
+	case "Subscription.unreadNoti":
+		if e.complexity.Subscription.UnreadNoti == nil {
+			break
+		}
+
+		return e.complexity.Subscription.UnreadNoti(childComplexity), true
+
+	case "SystemNoti.content":
+		if e.complexity.SystemNoti.Content == nil {
+			break
+		}
+
+		return e.complexity.SystemNoti.Content(childComplexity), true
+
+	case "SystemNoti.title":
+		if e.complexity.SystemNoti.Title == nil {
+			break
+		}
+
+		return e.complexity.SystemNoti.Title(childComplexity), true
+
+	case "Tag.activeAt":
+		if e.complexity.Tag.ActiveAt == nil {
+			break
+		}
+
+		return e.complexity.Tag.ActiveAt(childComplexity), true
+
+	case "Tag.description":
+		if e.complexity.Tag.Description == nil {
+			break
+		}
+
+		return e.complexity.Tag.Description(childComplexity), true
+
+	case "Tag.isMain":
+		if e.complexity.Tag.IsMain == nil {
+			break
+		}
+
+		return e.complexity.Tag.IsMain(childComplexity), true
+
+	case "Tag.name":
+		if e.complexity.Tag.Name == nil {
+			break
+		}
+
+		return e.complexity.Tag.Name(childComplexity), true
+
+	case "Tag.rules":
+		if e.complexity.Tag.Rules == nil {
+			break
+		}
+
+		return e.complexity.Tag.Rules(childComplexity), true
+
+	case "Tag.subscriberCount":
+		if e.complexity.Tag.SubscriberCount == nil {
+			break
+		}
+
+		return e.complexity.Tag.SubscriberCount(childComplexity), true
+
+	case "Tag.threadCount":
+		if e.complexity.Tag.ThreadCount == nil {
+			break
+		}
+
+		return e.complexity.Tag.ThreadCount(childComplexity), true
+
+	case "TagRole.role":
+		if e.complexity.TagRole.Role == nil {
+			break
+		}
+
+		return e.complexity.TagRole.Role(childComplexity), true
+
+	case "TagRole.tag":
+		if e.complexity.TagRole.Tag == nil {
+			break
+		}
+
+		return e.complexity.TagRole.Tag(childComplexity), true
+
+	case "Thread.author":
+		if e.complexity.Thread.Author == nil {
+			break
+		}
+
+		return e.complexity.Thread.Author(childComplexity), true
+
+	case "Thread.blocked":
+		if e.complexity.Thread.Blocked == nil {
+			break
+		}
+
+		return e.complexity.Thread.Blocked(childComplexity), true
+
+	case "Thread.bookmarked":
+		if e.complexity.Thread.Bookmarked == nil {
+			break
+		}
+
+		return e.complexity.Thread.Bookmarked(childComplexity), true
+
+	case "Thread.catalog":
+		if e.complexity.Thread.Catalog == nil {
+			break
+		}
+
+		return e.complexity.Thread.Catalog(childComplexity), true
+
+	case "Thread.content":
+		if e.complexity.Thread.Content == nil {
+			break
+		}
+
+		return e.complexity.Thread.Content(childComplexity), true
+
+	case "Thread.createdAt":
+		if e.complexity.Thread.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Thread.CreatedAt(childComplexity), true
+
+	case "Thread.editedAt":
+		if e.complexity.Thread.EditedAt == nil {
+			break
+		}
+
+		return e.complexity.Thread.EditedAt(childComplexity), true
+
+	case "Thread.id":
+		if e.complexity.Thread.ID == nil {
+			break
+		}
+
+		return e.complexity.Thread.ID(childComplexity), true
+
+	case "Thread.locked":
+		if e.complexity.Thread.Locked == nil {
+			break
+		}
+
+		return e.complexity.Thread.Locked(childComplexity), true
+
+	case "Thread.mainTag":
+		if e.complexity.Thread.MainTag == nil {
+			break
+		}
+
+		return e.complexity.Thread.MainTag(childComplexity), true
+
+	case "Thread.pinned":
+		if e.complexity.Thread.Pinned == nil {
+			break
+		}
+
+		return e.complexity.Thread.Pinned(childComplexity), true
+
+	case "Thread.pinnedUntil":
+		if e.complexity.Thread.PinnedUntil == nil {
+			break
+		}
+
+		return e.complexity.Thread.PinnedUntil(childComplexity), true
+
+	case "Thread.replies":
+		if e.complexity.Thread.Replies == nil {
+			break
+		}
+
+		args, err := ec.field_Thread_replies_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Thread.Replies(childComplexity, args["query"].(entity.SliceQuery)), true
+
+	case "Thread.replyCount":
+		if e.complexity.Thread.ReplyCount == nil {
+			break
+		}
+
+		return e.complexity.Thread.ReplyCount(childComplexity), true
+
+	case "Thread.revisions":
+		if e.complexity.Thread.Revisions == nil {
+			break
+		}
+
+		return e.complexity.Thread.Revisions(childComplexity), true
+
+	case "Thread.subTags":
+		if e.complexity.Thread.SubTags == nil {
+			break
+		}
+
+		return e.complexity.Thread.SubTags(childComplexity), true
+
+	case "Thread.title":
+		if e.complexity.Thread.Title == nil {
+			break
+		}
+
+		return e.complexity.Thread.Title(childComplexity), true
+
+	case "Thread.watched":
+		if e.complexity.Thread.Watched == nil {
+			break
+		}
+
+		return e.complexity.Thread.Watched(childComplexity), true
+
+	case "ThreadCatalogItem.createdAt":
+		if e.complexity.ThreadCatalogItem.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.ThreadCatalogItem.CreatedAt(childComplexity), true
+
+	case "ThreadCatalogItem.postId":
+		if e.complexity.ThreadCatalogItem.PostID == nil {
+			break
+		}
+
+		return e.complexity.ThreadCatalogItem.PostID(childComplexity), true
+
+	case "ThreadOutline.content":
+		if e.complexity.ThreadOutline.Content == nil {
+			break
+		}
+
+		return e.complexity.ThreadOutline.Content(childComplexity), true
+
+	case "ThreadOutline.id":
+		if e.complexity.ThreadOutline.ID == nil {
+			break
+		}
+
+		return e.complexity.ThreadOutline.ID(childComplexity), true
+
+	case "ThreadOutline.mainTag":
+		if e.complexity.ThreadOutline.MainTag == nil {
+			break
+		}
+
+		return e.complexity.ThreadOutline.MainTag(childComplexity), true
+
+	case "ThreadOutline.subTags":
+		if e.complexity.ThreadOutline.SubTags == nil {
+			break
+		}
+
+		return e.complexity.ThreadOutline.SubTags(childComplexity), true
+
+	case "ThreadOutline.title":
+		if e.complexity.ThreadOutline.Title == nil {
+			break
+		}
+
+		return e.complexity.ThreadOutline.Title(childComplexity), true
+
+	case "ThreadSlice.sliceInfo":
+		if e.complexity.ThreadSlice.SliceInfo == nil {
+			break
+		}
+
+		return e.complexity.ThreadSlice.SliceInfo(childComplexity), true
+
+	case "ThreadSlice.threads":
+		if e.complexity.ThreadSlice.Threads == nil {
+			break
+		}
+
+		return e.complexity.ThreadSlice.Threads(childComplexity), true
+
+	case "UnreadNotiEvent.notification":
+		if e.complexity.UnreadNotiEvent.Notification == nil {
+			break
+		}
+
+		return e.complexity.UnreadNotiEvent.Notification(childComplexity), true
+
+	case "UnreadNotiEvent.unreadCount":
+		if e.complexity.UnreadNotiEvent.UnreadCount == nil {
+			break
+		}
+
+		return e.complexity.UnreadNotiEvent.UnreadCount(childComplexity), true
+
+	case "User.autoWatch":
+		if e.complexity.User.AutoWatch == nil {
+			break
+		}
+
+		return e.complexity.User.AutoWatch(childComplexity), true
+
+	case "User.banExpiresAt":
+		if e.complexity.User.BanExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.User.BanExpiresAt(childComplexity), true
+
+	case "User.banReason":
+		if e.complexity.User.BanReason == nil {
+			break
+		}
+
+		return e.complexity.User.BanReason(childComplexity), true
+
+	case "User.bookmarks":
+		if e.complexity.User.Bookmarks == nil {
+			break
+		}
+
+		args, err := ec.field_User_bookmarks_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.User.Bookmarks(childComplexity, args["query"].(entity.SliceQuery)), true
+
+	case "User.email":
+		if e.complexity.User.Email == nil {
+			break
+		}
+
+		return e.complexity.User.Email(childComplexity), true
+
+	case "User.hiddenThreads":
+		if e.complexity.User.HiddenThreads == nil {
+			break
+		}
+
+		args, err := ec.field_User_hiddenThreads_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.User.HiddenThreads(childComplexity, args["query"].(entity.SliceQuery)), true
+
+	case "User.mutedTags":
+		if e.complexity.User.MutedTags == nil {
+			break
+		}
+
+		return e.complexity.User.MutedTags(childComplexity), true
+
+	case "User.name":
+		if e.complexity.User.Name == nil {
+			break
+		}
+
+		return e.complexity.User.Name(childComplexity), true
+
+	case "User.posts":
+		if e.complexity.User.Posts == nil {
+			break
+		}
+
+		args, err := ec.field_User_posts_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.User.Posts(childComplexity, args["query"].(entity.SliceQuery)), true
+
+	case "User.role":
+		if e.complexity.User.Role == nil {
+			break
+		}
+
+		return e.complexity.User.Role(childComplexity), true
+
+	case "User.tagRoles":
+		if e.complexity.User.TagRoles == nil {
+			break
+		}
+
+		return e.complexity.User.TagRoles(childComplexity), true
+
+	case "User.tags":
+		if e.complexity.User.Tags == nil {
+			break
+		}
+
+		return e.complexity.User.Tags(childComplexity), true
+
+	case "User.threads":
+		if e.complexity.User.Threads == nil {
+			break
+		}
+
+		args, err := ec.field_User_threads_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.User.Threads(childComplexity, args["query"].(entity.SliceQuery)), true
+
+	}
+	return 0, false
+}
+
+func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	rc := graphql.GetOperationContext(ctx)
+	ec := executionContext{rc, e}
+	first := true
+
+	switch rc.Operation.Operation {
+	case ast.Query:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			data := ec._Query(ctx, rc.Operation.SelectionSet)
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Mutation:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			data := ec._Mutation(ctx, rc.Operation.SelectionSet)
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, rc.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next()
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+
+	default:
+		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
+	}
+}
+
+type executionContext struct {
+	*graphql.OperationContext
+	*executableSchema
+}
+
+func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapSchema(parsedSchema), nil
+}
+
+func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapTypeFromDef(parsedSchema, parsedSchema.Types[name]), nil
+}
+
+var sources = []*ast.Source{
+	&ast.Source{Name: "schema/base.gql", Input: `scalar Time
+
+""" UID type is a universal id in this service.
+It's base64-like string when displayed, 60-bit integer in storage."""
+scalar UID
+
+""" SliceInfo objects are generated by the server.
+Can be used in consecutive queries."""
+type SliceInfo {
+  firstCursor: String!
+  lastCursor: String!
+  """ If more results exist after lastCursor."""
+  hasNext: Boolean!
+}
+
+""" SliceQuery object is for selecting specific 'slice' of an object to return.
+Affects the returning SliceInfo."""
+input SliceQuery {
+  """ Either this field or 'after' is required
+  An empty string means slice from the beginning."""
+  before: String
+  """ Either this field or 'before' is required.
+  An empty string means slice to the end."""
+  after: String
+  """ Set the amount of returned items."""
+  limit: Int!
+}
+
+""" Author for thread and post """
+type Author {
+  """ Author is anonymous or not."""
+  anonymous: Boolean!
+  """ Author display name. It's UID format if anonymous, user's name otherwise. """
+  author: String!
+  """ Author is the one who published the thread. """
+  isOP: Boolean!
+}
+
+""" A previous version of thread or post."""
+type Revision {
+  """ Time when this version was published or edited."""
+  createdAt: Time!
+  """ Title of thread, null for post."""
+  title: String
+  """ Markdown formatted content."""
+  content: String!
+}
+`, BuiltIn: false},
+	&ast.Source{Name: "schema/bookmark.gql", Input: `extend type Mutation {
+  """ Bookmark a thread or a post, one of 'threadId' and 'postId' is required."""
+  bookmark(threadId: UID, postId: UID): Boolean!
+  """ Remove a thread or a post from bookmarks, one of 'threadId' and 'postId' is required."""
+  unbookmark(threadId: UID, postId: UID): Boolean!
+}
+
+""" Union type of bookmarked items."""
+union BookmarkItem = Thread | Post
+
+""" BookmarkSlice object is for selecting specific 'slice' of bookmarked items."""
+type BookmarkSlice {
+  items: [BookmarkItem!]!
+  sliceInfo: SliceInfo!
+}
+`, BuiltIn: false},
+	&ast.Source{Name: "schema/modlog.gql", Input: `extend type Query {
+  """ Operations for administrators. Moderation actions, newest first."""
+  modLog(query: SliceQuery!): ModLogSlice!
+}
+
+enum ModAction {
+  blockPost
+  unblockPost
+  blockThread
+  unblockThread
+  lockThread
+  unlockThread
+  pinThread
+  unpinThread
+  editTags
+  banUser
+  unbanUser
+  banNetwork
+  unbanNetwork
+  setUserRole
+}
+
+""" Record of a moderation action."""
+type ModLog {
+  id: UID!
+  createdAt: Time!
+  """ The moderator took the action, null if the user is not found or the action is taken by command line tools."""
+  actor: User
+  action: ModAction!
+  """ ID of the post, thread, user or network ban."""
+  targetId: UID!
+  reason: String
+  """ JSON encoded state of the target before the action."""
+  before: String!
+  """ JSON encoded state of the target after the action."""
+  after: String!
+}
+
+type ModLogSlice {
+  logs: [ModLog!]!
+  sliceInfo: SliceInfo!
+}
+`, BuiltIn: false},
+	&ast.Source{Name: "schema/netban.gql", Input: `extend type Query {
+  """ Operations for moderators. Network bans, newest first."""
+  netBans(query: SliceQuery!): NetBanSlice!
+}
+
+extend type Mutation {
+  """ Operations for moderators. Ban the network which the post or thread is published from,
+  for 'duration' hours or forever if it's not set. 'prefix' is the length of network prefix,
+  default to 32 for IPv4 and 64 for IPv6. Users in the network can not sign in as guest or publish."""
+  banNetwork(postId: UID, threadId: UID, prefix: Int, reason: String, duration: Int): NetBan!
+  """ Operations for moderators."""
+  unbanNetwork(id: UID!, reason: String): Boolean!
+}
+
+""" Ban of a network, the network itself is never exposed."""
+type NetBan {
+  id: UID!
+  createdAt: Time!
+  """ The thread or post which the ban is created from."""
+  targetId: UID!
+  reason: String
+  """ Null if banned forever."""
+  expiresAt: Time
+}
+
+type NetBanSlice {
+  bans: [NetBan!]!
+  sliceInfo: SliceInfo!
+}
+`, BuiltIn: false},
+	&ast.Source{Name: "schema/notification.gql", Input: `extend type Query {
+  """ The count of unread notifications. """
+  unreadNotiCount: Int!
+  """ Notifications for current user. """
+  notification(query: SliceQuery!): NotiSlice!
+}
+
+extend type Subscription {
+  """ Pushed when a notification for current user is created or updated. """
+  unreadNoti: UnreadNotiEvent!
+}
+
+""" Object describing a change of current user's notifications. """
+type UnreadNotiEvent {
+  """ The count of unread notifications. """
+  unreadCount: Int!
+  """ The notification created or updated. """
+  notification: Notification!
+}
+
+enum NotiType {
+  system
+  replied
+  quoted
+}
+
+""" NotiSlice object is for selecting specific 'slice' of an object to return.
+Affects the returning SliceInfo. """
+type NotiSlice {
+  notifications: [Notification!]!
+  sliceInfo: SliceInfo!
+}
+
+type Notification {
+  """ Type of Notification. "system", "replied" or "quoted"."""
+  type: NotiType!
+  """ Time when a notify event triggers. E.g. The time when a system event is
+  announced from the server."""
+  eventTime: Time!
+  """ The notification is read or not."""
+  hasRead: Boolean!
+  """ notification content for different type."""
+  content: NotiContent!
+}
+
+""" Union type of Notificatiion contents """
+union NotiContent = SystemNoti | RepliedNoti | QuotedNoti
+
+""" Object describing contents of a system notification."""
+type SystemNoti {
+  """ Notification title."""
+  title: String!
+  """ Markdown formatted notification content."""
+  content: String!
+}
+
+""" Stripped version of Thread object."""
+type ThreadOutline {
+  """ thread.id """
+  id: UID!
+  """ thread.title """
+  title: String
+  """ Markdown formatted content."""
+  content: String!
+  mainTag: String!
+  subTags: [String!]!
+}
+
+""" Stripped version of Post object."""
+type PostOutline {
+  """ post.id """
+  id: UID!
+  """ post.author """
+  author: Author!
+  """ Markdown formatted content."""
+  content: String!
+}
+
+""" Object describing contents of a replied notification."""
+type RepliedNoti {
+  """ The Thread object that is replied."""
+  thread: ThreadOutline!
+  """ How much new replies since the last read of this notification."""
+  newRepliesCount: Int!
+  """ ID of the first reply since the last read of this notification."""
+  firstReplyId: UID!
+}
+
+""" Object describing contents of a quoted notification."""
+type QuotedNoti {
+  """ ID of the Thread quoted."""
+  threadId: UID!
+  """ The Post object quoted."""
+  quotedPost: PostOutline!
+  """ The Post object that made this notification."""
+  post: PostOutline!
+}
+`, BuiltIn: false},
+	&ast.Source{Name: "schema/post.gql", Input: `extend type Query {
+  """ A post object."""
+  post(id: UID!): Post!
+}
+
+extend type Mutation {
+  """ Publish a new post."""
+  pubPost(post: PostInput!): Post!
+  """ Edit content of the post, only by its author in a limited time after publishing."""
+  editPost(postId: UID!, content: String!): Post!
+  """ Operations for moderators."""
+  blockPost(postId: UID!, reason: String): Post!
+  """ Operations for moderators. The original content is restored."""
+  unblockPost(postId: UID!, reason: String): Post!
+}
+
+extend type Subscription {
+  """ New posts replying the thread."""
+  threadReplies(threadId: UID!): Post!
+}
+
+""" Input object describing a Post to be published."""
+input PostInput {
+  """ ID of the replying thread's."""
+  threadId: UID!
+  """ Should be sent anonymously or not."""
+  anonymous: Boolean!
+  """ Markdown formatted content."""
+  content: String!
+  """ Set quoting PostIDs."""
+  quoteIds: [UID!]
+}
+
+""" Object describing a Post."""
+type Post {
+  id: UID!
+  createdAt: Time!
+  author: Author!
+  """ Markdown formatted content."""
+  content: String!
+  """ Other posts that the post has quoted."""
+  quotes: [Post!]
+  """ Amount of times that the post is quoted."""
+  quotedCount: Int!
+  """ The post is blocked or not."""
+  blocked: Boolean!
+  """ Current user has bookmarked the post or not."""
+  bookmarked: Boolean!
+  """ Time of the last edit, null if never edited."""
+  editedAt: Time
+  """ Previous versions of the post, newest first. Only visible to moderators."""
+  revisions: [Revision!]
+}
+
+""" PostSlice object is for selecting specific 'slice' of Post objects to
+return. Affects the returning SliceInfo."""
+type PostSlice {
+  posts: [Post!]!
+  sliceInfo: SliceInfo!
+}
+`, BuiltIn: false},
+	&ast.Source{Name: "schema/report.gql", Input: `extend type Query {
+  """ Operations for moderators. Reports of threads and posts, newest first."""
+  reports(status: ReportStatus = pending, query: SliceQuery!): ReportSlice!
+}
+
+extend type Mutation {
+  """ Report a thread to moderators, only once for each user."""
+  reportThread(threadId: UID!, reason: ReportReason!, text: String): Boolean!
+  """ Report a post to moderators, only once for each user."""
+  reportPost(postId: UID!, reason: ReportReason!, text: String): Boolean!
+  """ Operations for moderators. Resolve a pending report, and take actions on the reported content."""
+  resolveReport(reportId: UID!, action: ReportAction, reason: String): Report!
+  """ Operations for moderators. Dismiss a pending report without any action."""
+  dismissReport(reportId: UID!): Report!
+}
+
+enum ReportReason {
+  """ Advertisement or flooding."""
+  spam
+  """ Discrimination, hate or harassment."""
+  abuse
+  """ Against the law or community rules."""
+  illegal
+  """ Not related to the tags."""
+  offTopic
+  """ Explained in text."""
+  other
+}
+
+enum ReportStatus {
+  pending
+  resolved
+  dismissed
+}
+
+""" Actions taken on the reported content when resolving a report."""
+input ReportAction {
+  """ Block the reported post, or the thread if a thread is reported."""
+  block: Boolean
+  """ Lock the thread of reported content."""
+  lock: Boolean
+  """ Ban the author of reported content."""
+  banUser: Boolean
+  """ Ban duration in hours, forever if it's not set."""
+  banDuration: Int
+}
+
+type Report {
+  id: UID!
+  createdAt: Time!
+  reason: ReportReason!
+  """ Explanation of the reporter."""
+  text: String
+  status: ReportStatus!
+  """ Time when the report is resolved or dismissed."""
+  handledAt: Time
+  """ Thread reported, or thread of the reported post."""
+  thread: Thread!
+  """ Post reported, null if a thread is reported."""
+  post: Post
+}
+
+type ReportSlice {
+  reports: [Report!]!
+  sliceInfo: SliceInfo!
+}
+`, BuiltIn: false},
+	&ast.Source{Name: "schema/search.gql", Input: `extend type Query {
+  """ Search threads and posts by text, ranked by relevance."""
+  search(text: String!, tags: [String!], query: SliceQuery!): SearchSlice!
+}
+
+""" Union type of searching results."""
+union SearchItem = Thread | Post
+
+""" SearchSlice object is for selecting specific 'slice' of searching results."""
+type SearchSlice {
+  items: [SearchItem!]!
+  sliceInfo: SliceInfo!
+}
+`, BuiltIn: false},
+	&ast.Source{Name: "schema/tag.gql", Input: `extend type Query {
+  """ Main Tags."""
+  mainTags: [String!]!
+  """ Tag found by name, main tags are always found."""
+  tag(name: String!): Tag!
+  """ Tags that are recommended, trending sub tags related to subscribed tags of current user."""
+  recommended: [String!]!
+  """ Searching tags by keyword."""
+  tags(
+    """ Search keyword."""
+    query: String,
+    """ Amount of tags returned."""
+    limit: Int,
+  ): [Tag!]!
+  """ Sub tags active in recent threads and replies, refreshed periodically."""
+  trendingTags(
+    """ Time range of activity counted."""
+    window: TrendingWindow! = day,
+    """ Amount of tags returned."""
+    limit: Int,
+  ): [Tag!]!
+}
+
+extend type Mutation {
+  """ Operations for administrators, they return main tags after changed.
+  Add a main tag to the end, a retired main tag is restored."""
+  addMainTag(tag: String!): [String!]!
+  """ Rename a main tag, threads and users with it are updated as well."""
+  renameMainTag(tag: String!, newName: String!): [String!]!
+  """ Retire a main tag, new threads can't use it but existing threads keep it."""
+  retireMainTag(tag: String!): [String!]!
+  """ Sort main tags, 'tags' must contain all main tags."""
+  reorderMainTags(tags: [String!]!): [String!]!
+  """ Operations for administrators. Merge sub tag 'from' into 'into', threads and users with it are updated,
+  and 'from' becomes an alias of 'into' for new content."""
+  mergeTags(from: String!, into: String!): Boolean!
+  """ Operations for administrators. Edit description and posting rules of a main tag, null clears them."""
+  editTag(name: String!, description: String, rules: String): Tag!
+}
+
+type Tag {
+  """ Name of tag."""
+  name: String!
+  """ The tag is a MainTag if true, SubTag otherwise."""
+  isMain: Boolean!
+  """ Description of main tag."""
+  description: String
+  """ Posting rules of main tag, in markdown."""
+  rules: String
+  """ Count of threads with the tag."""
+  threadCount: Int!
+  """ Count of users subscribing the tag."""
+  subscriberCount: Int!
+  """ Time of the last thread or reply with the tag, null if there is none."""
+  activeAt: Time
+}
+
+enum TrendingWindow {
+  """ Last 24 hours."""
+  day
+  """ Last 7 days."""
+  week
+}
+`, BuiltIn: false},
+	&ast.Source{Name: "schema/thread.gql", Input: `extend type Query {
+  """ A slice of Thread."""
+  threadSlice(tags: [String!], sort: ThreadSort = bump, query: SliceQuery!): ThreadSlice!
+  """ Threads in subscribed tags of current user, or in main tags if there is none. Blocked threads are left out."""
+  timeline(query: SliceQuery!): ThreadSlice!
+  """ A Thread object."""
+  thread(id: UID!): Thread!
+}
+
+extend type Mutation {
+  """ Publish a new Thread."""
+  pubThread(thread: ThreadInput!): Thread!
+  """ Edit title and content of the Thread, only by its author in a limited time after publishing."""
+  editThread(threadId: UID!, title: String, content: String!): Thread!
+  """ Receive replied notifications of the Thread. Not available for guests."""
+  watchThread(threadId: UID!): Thread!
+  """ Stop receiving replied notifications of the Thread."""
+  unwatchThread(threadId: UID!): Thread!
+  """ Hide the Thread from thread slices, timeline and search of current user."""
+  hideThread(threadId: UID!): Thread!
+  """ Show the hidden Thread again."""
+  unhideThread(threadId: UID!): Thread!
+  """ Operations for moderators."""
+  lockThread(threadId: UID!, reason: String): Thread!
+  """ Operations for moderators."""
+  unlockThread(threadId: UID!, reason: String): Thread!
+  """ Operations for moderators."""
+  blockThread(threadId: UID!, reason: String): Thread!
+  """ Operations for moderators. The original title and content are restored."""
+  unblockThread(threadId: UID!, reason: String): Thread!
+  """ Operations for moderators. Pin the thread at the top of its main tag, forever if 'until' is not set."""
+  pinThread(threadId: UID!, until: Time, reason: String): Thread!
+  """ Operations for moderators."""
+  unpinThread(threadId: UID!, reason: String): Thread!
+  """ Operations for moderators."""
+  editTags(threadId: UID!, mainTag: String!, subTags: [String!]!, reason: String): Thread!
+}
+
+""" Construct a new Thread."""
+input ThreadInput {
+  """ Toggle anonymousness. If true, a new ID will be generated in each thread."""
+  anonymous: Boolean!
+  """ Markdown formmatted text. """
+  content: String!
+  """ Required. Only one mainTag is allowed."""
+  mainTag: String!
+  """ Optional, maximum of 4."""
+  subTags: [String!]
+  """ Optional. If not set, the title will be '无题'."""
+  title: String
+}
+
+type Thread {
+  id: UID!
+  createdAt: Time!
+  author: Author!
+  title: String
+  """ Markdown formatted content."""
+  content: String!
+  """ Only one mainTag is allowed."""
+  mainTag: String!
+  """ Optional, maximum of 4."""
+  subTags: [String!]!
+  """ Replied posts."""
+  replies(query: SliceQuery!): PostSlice!
+  """ Amount of posts replied."""
+  replyCount: Int!
+  """ A list of all posts replied in the thread. Sorted by timestamp."""
+  catalog: [ThreadCatalogItem!]
+  """ Current user is watching the thread or not."""
+  watched: Boolean!
+  """ Current user has bookmarked the thread or not."""
+  bookmarked: Boolean!
+  """ Thread is blocked."""
+  blocked: Boolean!
+  """ Thread is locked."""
+  locked: Boolean!
+  """ Thread is pinned at the top of its main tag."""
+  pinned: Boolean!
+  """ Time when the pin expires, null if pinned forever or not pinned."""
+  pinnedUntil: Time
+  """ Time of the last edit, null if never edited."""
+  editedAt: Time
+  """ Previous versions of the thread, newest first. Only visible to moderators."""
+  revisions: [Revision!]
+}
+
+""" The ID and timestamp of post replied in the thread."""
+type ThreadCatalogItem {
+  """ The ID of post."""
+  postId: UID!
+  createdAt: Time!
+}
+
+""" Sorting order of thread slice, all in descending order."""
+enum ThreadSort {
+  """ By the latest reply, default value."""
+  bump
+  """ By publishing time."""
+  newest
+  """ By amount of replies."""
+  replies
+  """ By the popularity decayed over time."""
+  hot
+}
+
+type ThreadSlice {
+  threads: [Thread!]!
+  sliceInfo: SliceInfo!
+}
+`, BuiltIn: false},
+	&ast.Source{Name: "schema/user.gql", Input: `extend type Query {
+  """ A user profile object."""
+  profile: User!
+}
+
+extend type Mutation {
+  """ Register/Login via email address.
+  An email containing sign info will be sent to the provided email address. 'redirectTo' must start as '/'.
+  If the user succeed signed in, will be go to ` + "`" + `redirectTo` + "`" + ` or ` + "`" + `/` + "`" + ` (if not specified). """
+  emailAuth(email: String!, redirectTo: String): Boolean!
+  """ Set the Name of user."""
+  setName(name: String!): User!
+  """ Directly edit tags subscribed by user."""
+  syncTags(tags: [String!]!): User!
+  """ Add tags subscribed by user."""
+  addSubbedTag(tag: String!): User!
+  """ Delete tags subscribed by user."""
+  delSubbedTag(tag: String!): User!
+  """ Mute the tag, threads with it are left out unless the tag is requested explicitly."""
+  muteTag(tag: String!): User!
+  """ Unmute the tag."""
+  unmuteTag(tag: String!): User!
+  """ Toggle watching threads automatically after replying."""
+  setAutoWatch(enable: Boolean!): User!
+
+  """ Operations for moderators. Ban the user for 'duration' hours, forever if it's not set.
+  The reason is shown to the banned user."""
+  banUser(postId: UID, threadId: UID, reason: String, duration: Int): Boolean!
+  """ Operations for moderators. Restore the role of user before banned."""
+  unbanUser(postId: UID, threadId: UID, reason: String): Boolean!
+  """ Operations for administrators. Set role of the user found by email or id, or the role in main tag if 'tag' is set.
+  Only mod can be granted in main tag, set to normal to revoke it."""
+  setUserRole(email: String, userId: UID, role: Role!, tag: String, reason: String): User!
+}
+
+enum Role {
+  """ Administrators """
+  admin
+  """ Moderators """
+  mod
+  """ Normal role, default value for signed up user """
+  normal
+  """ For not signed up user """
+  guest
+  """ Banned user """
+  banned
+}
+
+type User {
+  """ Only exists when the user has signed up via email. """
+  email: String
+  """ The Name of user. Required when not posting anonymously."""
+  name: String
+  """ Tags subscribed by the user."""
+  tags: [String!]
+  """ Current role of the user."""
+  role: Role!
+  """ Watch threads automatically after replying, default to true."""
+  autoWatch: Boolean!
+  """ Why the user is banned, only for banned user."""
+  banReason: String
+  """ When the ban expires, null if banned forever or not banned."""
+  banExpiresAt: Time
+  """ Roles granted in threads of main tags, such as moderators of one main tag."""
+  tagRoles: [TagRole!]!
+  """ Tags muted by the user."""
+  mutedTags: [String!]
+
+  # Threads published by the user.
+  threads(query: SliceQuery!): ThreadSlice!
+  # Threads replied by the user.
+  posts(query: SliceQuery!): PostSlice!
+  """ Threads and posts bookmarked by the user, latest bookmarked first."""
+  bookmarks(query: SliceQuery!): BookmarkSlice!
+  """ Threads hidden by the user, latest hidden first."""
+  hiddenThreads(query: SliceQuery!): ThreadSlice!
+}
+
+""" Role granted to user in threads of the main tag."""
+type TagRole {
+  tag: String!
+  role: Role!
+}
+`, BuiltIn: false},
+}
+var parsedSchema = gqlparser.MustLoadSchema(sources...)
+
+// endregion ************************** generated!.gotpl **************************
+
+// region    ***************************** args.gotpl *****************************
+
+func (ec *executionContext) field_Mutation_addMainTag_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["tag"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["tag"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_addSubbedTag_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["tag"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["tag"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_banNetwork_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *uid.UID
+	if tmp, ok := rawArgs["postId"]; ok {
+		arg0, err = ec.unmarshalOUID2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["postId"] = arg0
+	var arg1 *uid.UID
+	if tmp, ok := rawArgs["threadId"]; ok {
+		arg1, err = ec.unmarshalOUID2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["threadId"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["prefix"]; ok {
+		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["prefix"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["reason"]; ok {
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["reason"] = arg3
+	var arg4 *int
+	if tmp, ok := rawArgs["duration"]; ok {
+		arg4, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["duration"] = arg4
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_banUser_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *uid.UID
+	if tmp, ok := rawArgs["postId"]; ok {
+		arg0, err = ec.unmarshalOUID2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["postId"] = arg0
+	var arg1 *uid.UID
+	if tmp, ok := rawArgs["threadId"]; ok {
+		arg1, err = ec.unmarshalOUID2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["threadId"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["reason"]; ok {
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["reason"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["duration"]; ok {
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["duration"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_blockPost_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 uid.UID
+	if tmp, ok := rawArgs["postId"]; ok {
+		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["postId"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["reason"]; ok {
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["reason"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_blockThread_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 uid.UID
+	if tmp, ok := rawArgs["threadId"]; ok {
+		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["threadId"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["reason"]; ok {
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["reason"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_bookmark_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *uid.UID
+	if tmp, ok := rawArgs["threadId"]; ok {
+		arg0, err = ec.unmarshalOUID2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["threadId"] = arg0
+	var arg1 *uid.UID
+	if tmp, ok := rawArgs["postId"]; ok {
+		arg1, err = ec.unmarshalOUID2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["postId"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_delSubbedTag_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["tag"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["tag"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_dismissReport_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 uid.UID
+	if tmp, ok := rawArgs["reportId"]; ok {
+		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["reportId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_editPost_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 uid.UID
+	if tmp, ok := rawArgs["postId"]; ok {
+		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["postId"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["content"]; ok {
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["content"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_editTag_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["name"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["name"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["description"]; ok {
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["description"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["rules"]; ok {
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["rules"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_editTags_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 uid.UID
+	if tmp, ok := rawArgs["threadId"]; ok {
+		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["threadId"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["mainTag"]; ok {
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["mainTag"] = arg1
+	var arg2 []string
+	if tmp, ok := rawArgs["subTags"]; ok {
+		arg2, err = ec.unmarshalNString2ᚕstringᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["subTags"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["reason"]; ok {
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["reason"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_editThread_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 uid.UID
+	if tmp, ok := rawArgs["threadId"]; ok {
+		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["threadId"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["title"]; ok {
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["title"] = arg1
+	var arg2 string
+	if tmp, ok := rawArgs["content"]; ok {
+		arg2, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["content"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_emailAuth_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["email"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["email"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["redirectTo"]; ok {
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["redirectTo"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_hideThread_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 uid.UID
+	if tmp, ok := rawArgs["threadId"]; ok {
+		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["threadId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_lockThread_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 uid.UID
+	if tmp, ok := rawArgs["threadId"]; ok {
+		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["threadId"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["reason"]; ok {
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["reason"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_mergeTags_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["from"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["from"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["into"]; ok {
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["into"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_muteTag_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["tag"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["tag"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_pinThread_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 uid.UID
+	if tmp, ok := rawArgs["threadId"]; ok {
+		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["threadId"] = arg0
+	var arg1 *time.Time
+	if tmp, ok := rawArgs["until"]; ok {
+		arg1, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["until"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["reason"]; ok {
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["reason"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_pubPost_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 entity.PostInput
+	if tmp, ok := rawArgs["post"]; ok {
+		arg0, err = ec.unmarshalNPostInput2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["post"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_pubThread_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 entity.ThreadInput
+	if tmp, ok := rawArgs["thread"]; ok {
+		arg0, err = ec.unmarshalNThreadInput2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThreadInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["thread"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_renameMainTag_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["tag"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["tag"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["newName"]; ok {
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["newName"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_reorderMainTags_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []string
+	if tmp, ok := rawArgs["tags"]; ok {
+		arg0, err = ec.unmarshalNString2ᚕstringᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["tags"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_reportPost_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 uid.UID
+	if tmp, ok := rawArgs["postId"]; ok {
+		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["postId"] = arg0
+	var arg1 entity.ReportReason
+	if tmp, ok := rawArgs["reason"]; ok {
+		arg1, err = ec.unmarshalNReportReason2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReportReason(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["reason"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["text"]; ok {
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["text"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_reportThread_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 uid.UID
+	if tmp, ok := rawArgs["threadId"]; ok {
+		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["threadId"] = arg0
+	var arg1 entity.ReportReason
+	if tmp, ok := rawArgs["reason"]; ok {
+		arg1, err = ec.unmarshalNReportReason2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReportReason(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["reason"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["text"]; ok {
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["text"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_resolveReport_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 uid.UID
+	if tmp, ok := rawArgs["reportId"]; ok {
+		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["reportId"] = arg0
+	var arg1 *entity.ReportAction
+	if tmp, ok := rawArgs["action"]; ok {
+		arg1, err = ec.unmarshalOReportAction2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReportAction(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["action"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["reason"]; ok {
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["reason"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_retireMainTag_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["tag"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["tag"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_setAutoWatch_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["enable"]; ok {
+		arg0, err = ec.unmarshalNBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["enable"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_setName_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["name"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["name"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_setUserRole_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["email"]; ok {
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["email"] = arg0
+	var arg1 *uid.UID
+	if tmp, ok := rawArgs["userId"]; ok {
+		arg1, err = ec.unmarshalOUID2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["userId"] = arg1
+	var arg2 entity.Role
+	if tmp, ok := rawArgs["role"]; ok {
+		arg2, err = ec.unmarshalNRole2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐRole(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["role"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["tag"]; ok {
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["tag"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["reason"]; ok {
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["reason"] = arg4
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_syncTags_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []string
+	if tmp, ok := rawArgs["tags"]; ok {
+		arg0, err = ec.unmarshalNString2ᚕstringᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["tags"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_unbanNetwork_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 uid.UID
+	if tmp, ok := rawArgs["id"]; ok {
+		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["reason"]; ok {
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["reason"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_unbanUser_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *uid.UID
+	if tmp, ok := rawArgs["postId"]; ok {
+		arg0, err = ec.unmarshalOUID2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["postId"] = arg0
+	var arg1 *uid.UID
+	if tmp, ok := rawArgs["threadId"]; ok {
+		arg1, err = ec.unmarshalOUID2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["threadId"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["reason"]; ok {
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["reason"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_unblockPost_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 uid.UID
+	if tmp, ok := rawArgs["postId"]; ok {
+		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["postId"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["reason"]; ok {
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["reason"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_unblockThread_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 uid.UID
+	if tmp, ok := rawArgs["threadId"]; ok {
+		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["threadId"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["reason"]; ok {
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["reason"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_unbookmark_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *uid.UID
+	if tmp, ok := rawArgs["threadId"]; ok {
+		arg0, err = ec.unmarshalOUID2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["threadId"] = arg0
+	var arg1 *uid.UID
+	if tmp, ok := rawArgs["postId"]; ok {
+		arg1, err = ec.unmarshalOUID2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["postId"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_unhideThread_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 uid.UID
+	if tmp, ok := rawArgs["threadId"]; ok {
+		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["threadId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_unlockThread_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 uid.UID
+	if tmp, ok := rawArgs["threadId"]; ok {
+		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["threadId"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["reason"]; ok {
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["reason"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_unmuteTag_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["tag"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["tag"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_unpinThread_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 uid.UID
+	if tmp, ok := rawArgs["threadId"]; ok {
+		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["threadId"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["reason"]; ok {
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["reason"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_unwatchThread_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 uid.UID
+	if tmp, ok := rawArgs["threadId"]; ok {
+		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["threadId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_watchThread_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 uid.UID
+	if tmp, ok := rawArgs["threadId"]; ok {
+		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["threadId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["name"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["name"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_modLog_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 entity.SliceQuery
+	if tmp, ok := rawArgs["query"]; ok {
+		arg0, err = ec.unmarshalNSliceQuery2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceQuery(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["query"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_netBans_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 entity.SliceQuery
+	if tmp, ok := rawArgs["query"]; ok {
+		arg0, err = ec.unmarshalNSliceQuery2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceQuery(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["query"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_notification_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 entity.SliceQuery
+	if tmp, ok := rawArgs["query"]; ok {
+		arg0, err = ec.unmarshalNSliceQuery2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceQuery(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["query"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_post_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 uid.UID
+	if tmp, ok := rawArgs["id"]; ok {
+		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_reports_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *entity.ReportStatus
+	if tmp, ok := rawArgs["status"]; ok {
+		arg0, err = ec.unmarshalOReportStatus2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReportStatus(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["status"] = arg0
+	var arg1 entity.SliceQuery
+	if tmp, ok := rawArgs["query"]; ok {
+		arg1, err = ec.unmarshalNSliceQuery2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceQuery(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["query"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_search_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["text"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["text"] = arg0
+	var arg1 []string
+	if tmp, ok := rawArgs["tags"]; ok {
+		arg1, err = ec.unmarshalOString2ᚕstringᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["tags"] = arg1
+	var arg2 entity.SliceQuery
+	if tmp, ok := rawArgs["query"]; ok {
+		arg2, err = ec.unmarshalNSliceQuery2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceQuery(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["query"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_tag_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["name"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["name"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_tags_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["query"]; ok {
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["query"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_threadSlice_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []string
+	if tmp, ok := rawArgs["tags"]; ok {
+		arg0, err = ec.unmarshalOString2ᚕstringᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["tags"] = arg0
+	var arg1 *entity.ThreadSort
+	if tmp, ok := rawArgs["sort"]; ok {
+		arg1, err = ec.unmarshalOThreadSort2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThreadSort(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["sort"] = arg1
+	var arg2 entity.SliceQuery
+	if tmp, ok := rawArgs["query"]; ok {
+		arg2, err = ec.unmarshalNSliceQuery2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceQuery(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["query"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_thread_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 uid.UID
+	if tmp, ok := rawArgs["id"]; ok {
+		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_timeline_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 entity.SliceQuery
+	if tmp, ok := rawArgs["query"]; ok {
+		arg0, err = ec.unmarshalNSliceQuery2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceQuery(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["query"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_trendingTags_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 entity.TrendingWindow
+	if tmp, ok := rawArgs["window"]; ok {
+		arg0, err = ec.unmarshalNTrendingWindow2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐTrendingWindow(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["window"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_threadReplies_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 uid.UID
+	if tmp, ok := rawArgs["threadId"]; ok {
+		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["threadId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Thread_replies_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 entity.SliceQuery
+	if tmp, ok := rawArgs["query"]; ok {
+		arg0, err = ec.unmarshalNSliceQuery2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceQuery(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["query"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_User_bookmarks_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 entity.SliceQuery
+	if tmp, ok := rawArgs["query"]; ok {
+		arg0, err = ec.unmarshalNSliceQuery2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceQuery(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["query"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_User_hiddenThreads_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 entity.SliceQuery
+	if tmp, ok := rawArgs["query"]; ok {
+		arg0, err = ec.unmarshalNSliceQuery2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceQuery(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["query"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_User_posts_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 entity.SliceQuery
+	if tmp, ok := rawArgs["query"]; ok {
+		arg0, err = ec.unmarshalNSliceQuery2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceQuery(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["query"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_User_threads_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 entity.SliceQuery
+	if tmp, ok := rawArgs["query"]; ok {
+		arg0, err = ec.unmarshalNSliceQuery2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceQuery(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["query"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _Author_anonymous(ctx context.Context, field graphql.CollectedField, obj *entity.Author) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Author",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Anonymous, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Author_author(ctx context.Context, field graphql.CollectedField, obj *entity.Author) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Author",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Author, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Author_isOP(ctx context.Context, field graphql.CollectedField, obj *entity.Author) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Author",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsOP, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _BookmarkSlice_items(ctx context.Context, field graphql.CollectedField, obj *entity.BookmarkSlice) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "BookmarkSlice",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Items, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]entity.BookmarkItem)
+	fc.Result = res
+	return ec.marshalNBookmarkItem2ᚕgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐBookmarkItemᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _BookmarkSlice_sliceInfo(ctx context.Context, field graphql.CollectedField, obj *entity.BookmarkSlice) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "BookmarkSlice",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SliceInfo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.SliceInfo)
+	fc.Result = res
+	return ec.marshalNSliceInfo2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ModLog_id(ctx context.Context, field graphql.CollectedField, obj *entity.ModLog) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ModLog",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uid.UID)
+	fc.Result = res
+	return ec.marshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ModLog_createdAt(ctx context.Context, field graphql.CollectedField, obj *entity.ModLog) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ModLog",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ModLog_actor(ctx context.Context, field graphql.CollectedField, obj *entity.ModLog) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ModLog",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ModLog().Actor(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*entity.User)
+	fc.Result = res
+	return ec.marshalOUser2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ModLog_action(ctx context.Context, field graphql.CollectedField, obj *entity.ModLog) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ModLog",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Action, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(entity.ModAction)
+	fc.Result = res
+	return ec.marshalNModAction2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐModAction(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ModLog_targetId(ctx context.Context, field graphql.CollectedField, obj *entity.ModLog) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ModLog",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TargetID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uid.UID)
+	fc.Result = res
+	return ec.marshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ModLog_reason(ctx context.Context, field graphql.CollectedField, obj *entity.ModLog) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ModLog",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Reason, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ModLog_before(ctx context.Context, field graphql.CollectedField, obj *entity.ModLog) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ModLog",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Before, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ModLog_after(ctx context.Context, field graphql.CollectedField, obj *entity.ModLog) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ModLog",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.After, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ModLogSlice_logs(ctx context.Context, field graphql.CollectedField, obj *entity.ModLogSlice) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ModLogSlice",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Logs, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*entity.ModLog)
+	fc.Result = res
+	return ec.marshalNModLog2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐModLogᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ModLogSlice_sliceInfo(ctx context.Context, field graphql.CollectedField, obj *entity.ModLogSlice) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ModLogSlice",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SliceInfo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.SliceInfo)
+	fc.Result = res
+	return ec.marshalNSliceInfo2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_bookmark(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_bookmark_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Bookmark(rctx, args["threadId"].(*uid.UID), args["postId"].(*uid.UID))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_unbookmark(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_unbookmark_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Unbookmark(rctx, args["threadId"].(*uid.UID), args["postId"].(*uid.UID))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_banNetwork(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_banNetwork_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().BanNetwork(rctx, args["postId"].(*uid.UID), args["threadId"].(*uid.UID), args["prefix"].(*int), args["reason"].(*string), args["duration"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.NetBan)
+	fc.Result = res
+	return ec.marshalNNetBan2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐNetBan(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_unbanNetwork(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_unbanNetwork_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UnbanNetwork(rctx, args["id"].(uid.UID), args["reason"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_pubPost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_pubPost_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().PubPost(rctx, args["post"].(entity.PostInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.Post)
+	fc.Result = res
+	return ec.marshalNPost2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPost(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_editPost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_editPost_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().EditPost(rctx, args["postId"].(uid.UID), args["content"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.Post)
+	fc.Result = res
+	return ec.marshalNPost2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPost(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_blockPost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_blockPost_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().BlockPost(rctx, args["postId"].(uid.UID), args["reason"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.Post)
+	fc.Result = res
+	return ec.marshalNPost2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPost(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_unblockPost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_unblockPost_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UnblockPost(rctx, args["postId"].(uid.UID), args["reason"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.Post)
+	fc.Result = res
+	return ec.marshalNPost2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPost(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_reportThread(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_reportThread_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ReportThread(rctx, args["threadId"].(uid.UID), args["reason"].(entity.ReportReason), args["text"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_reportPost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_reportPost_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ReportPost(rctx, args["postId"].(uid.UID), args["reason"].(entity.ReportReason), args["text"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_resolveReport(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_resolveReport_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ResolveReport(rctx, args["reportId"].(uid.UID), args["action"].(*entity.ReportAction), args["reason"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.Report)
+	fc.Result = res
+	return ec.marshalNReport2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReport(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_dismissReport(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_dismissReport_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DismissReport(rctx, args["reportId"].(uid.UID))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.Report)
+	fc.Result = res
+	return ec.marshalNReport2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReport(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_addMainTag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_addMainTag_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().AddMainTag(rctx, args["tag"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_renameMainTag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_renameMainTag_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RenameMainTag(rctx, args["tag"].(string), args["newName"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_retireMainTag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_retireMainTag_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RetireMainTag(rctx, args["tag"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_reorderMainTags(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_reorderMainTags_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ReorderMainTags(rctx, args["tags"].([]string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_mergeTags(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_mergeTags_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().MergeTags(rctx, args["from"].(string), args["into"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_editTag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_editTag_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().EditTag(rctx, args["name"].(string), args["description"].(*string), args["rules"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.Tag)
+	fc.Result = res
+	return ec.marshalNTag2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐTag(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_pubThread(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_pubThread_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().PubThread(rctx, args["thread"].(entity.ThreadInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.Thread)
+	fc.Result = res
+	return ec.marshalNThread2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThread(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_editThread(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_editThread_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().EditThread(rctx, args["threadId"].(uid.UID), args["title"].(*string), args["content"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.Thread)
+	fc.Result = res
+	return ec.marshalNThread2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThread(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_watchThread(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_watchThread_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().WatchThread(rctx, args["threadId"].(uid.UID))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.Thread)
+	fc.Result = res
+	return ec.marshalNThread2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThread(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_unwatchThread(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_unwatchThread_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UnwatchThread(rctx, args["threadId"].(uid.UID))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.Thread)
+	fc.Result = res
+	return ec.marshalNThread2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThread(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_hideThread(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_hideThread_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().HideThread(rctx, args["threadId"].(uid.UID))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.Thread)
+	fc.Result = res
+	return ec.marshalNThread2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThread(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_unhideThread(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_unhideThread_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UnhideThread(rctx, args["threadId"].(uid.UID))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.Thread)
+	fc.Result = res
+	return ec.marshalNThread2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThread(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_lockThread(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_lockThread_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().LockThread(rctx, args["threadId"].(uid.UID), args["reason"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.Thread)
+	fc.Result = res
+	return ec.marshalNThread2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThread(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_unlockThread(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_unlockThread_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UnlockThread(rctx, args["threadId"].(uid.UID), args["reason"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.Thread)
+	fc.Result = res
+	return ec.marshalNThread2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThread(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_blockThread(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_blockThread_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().BlockThread(rctx, args["threadId"].(uid.UID), args["reason"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.Thread)
+	fc.Result = res
+	return ec.marshalNThread2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThread(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_unblockThread(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_unblockThread_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UnblockThread(rctx, args["threadId"].(uid.UID), args["reason"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.Thread)
+	fc.Result = res
+	return ec.marshalNThread2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThread(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_pinThread(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_pinThread_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().PinThread(rctx, args["threadId"].(uid.UID), args["until"].(*time.Time), args["reason"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.Thread)
+	fc.Result = res
+	return ec.marshalNThread2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThread(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_unpinThread(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_unpinThread_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UnpinThread(rctx, args["threadId"].(uid.UID), args["reason"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.Thread)
+	fc.Result = res
+	return ec.marshalNThread2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThread(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_editTags(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_editTags_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().EditTags(rctx, args["threadId"].(uid.UID), args["mainTag"].(string), args["subTags"].([]string), args["reason"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.Thread)
+	fc.Result = res
+	return ec.marshalNThread2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThread(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_emailAuth(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_emailAuth_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().EmailAuth(rctx, args["email"].(string), args["redirectTo"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_setName(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_setName_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().SetName(rctx, args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_syncTags(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_syncTags_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().SyncTags(rctx, args["tags"].([]string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_addSubbedTag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_addSubbedTag_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().AddSubbedTag(rctx, args["tag"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_delSubbedTag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_delSubbedTag_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DelSubbedTag(rctx, args["tag"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_muteTag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_muteTag_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().MuteTag(rctx, args["tag"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_unmuteTag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_unmuteTag_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UnmuteTag(rctx, args["tag"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_setAutoWatch(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_setAutoWatch_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().SetAutoWatch(rctx, args["enable"].(bool))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_banUser(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_banUser_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().BanUser(rctx, args["postId"].(*uid.UID), args["threadId"].(*uid.UID), args["reason"].(*string), args["duration"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_unbanUser(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_unbanUser_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UnbanUser(rctx, args["postId"].(*uid.UID), args["threadId"].(*uid.UID), args["reason"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_setUserRole(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_setUserRole_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().SetUserRole(rctx, args["email"].(*string), args["userId"].(*uid.UID), args["role"].(entity.Role), args["tag"].(*string), args["reason"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NetBan_id(ctx context.Context, field graphql.CollectedField, obj *entity.NetBan) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "NetBan",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uid.UID)
+	fc.Result = res
+	return ec.marshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NetBan_createdAt(ctx context.Context, field graphql.CollectedField, obj *entity.NetBan) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "NetBan",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NetBan_targetId(ctx context.Context, field graphql.CollectedField, obj *entity.NetBan) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "NetBan",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TargetID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uid.UID)
+	fc.Result = res
+	return ec.marshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NetBan_reason(ctx context.Context, field graphql.CollectedField, obj *entity.NetBan) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "NetBan",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Reason, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NetBan_expiresAt(ctx context.Context, field graphql.CollectedField, obj *entity.NetBan) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "NetBan",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExpiresAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NetBanSlice_bans(ctx context.Context, field graphql.CollectedField, obj *entity.NetBanSlice) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "NetBanSlice",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Bans, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*entity.NetBan)
+	fc.Result = res
+	return ec.marshalNNetBan2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐNetBanᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NetBanSlice_sliceInfo(ctx context.Context, field graphql.CollectedField, obj *entity.NetBanSlice) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "NetBanSlice",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SliceInfo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.SliceInfo)
+	fc.Result = res
+	return ec.marshalNSliceInfo2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NotiSlice_notifications(ctx context.Context, field graphql.CollectedField, obj *entity.NotiSlice) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "NotiSlice",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Notifications, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*entity.Notification)
+	fc.Result = res
+	return ec.marshalNNotification2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐNotificationᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NotiSlice_sliceInfo(ctx context.Context, field graphql.CollectedField, obj *entity.NotiSlice) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "NotiSlice",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SliceInfo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.SliceInfo)
+	fc.Result = res
+	return ec.marshalNSliceInfo2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Notification_type(ctx context.Context, field graphql.CollectedField, obj *entity.Notification) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Notification",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(entity.NotiType)
+	fc.Result = res
+	return ec.marshalNNotiType2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐNotiType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Notification_eventTime(ctx context.Context, field graphql.CollectedField, obj *entity.Notification) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Notification",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EventTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Notification_hasRead(ctx context.Context, field graphql.CollectedField, obj *entity.Notification) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Notification",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HasRead, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-extend type Mutation {
-  """ Publish a new post."""
-  pubPost(post: PostInput!): Post!
-  """ Operations for moderators."""
-  blockPost(postId: UID!): Post!
+func (ec *executionContext) _Notification_content(ctx context.Context, field graphql.CollectedField, obj *entity.Notification) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Notification",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Content, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(entity.NotiContent)
+	fc.Result = res
+	return ec.marshalNNotiContent2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐNotiContent(ctx, field.Selections, res)
 }
 
-""" Input object describing a Post to be published."""
-input PostInput {
-  """ ID of the replying thread's."""
-  threadId: UID!
-  """ Should be sent anonymously or not."""
-  anonymous: Boolean!
-  """ Markdown formatted content."""
-  content: String!
-  """ Set quoting PostIDs."""
-  quoteIds: [UID!]
+func (ec *executionContext) _Post_id(ctx context.Context, field graphql.CollectedField, obj *entity.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uid.UID)
+	fc.Result = res
+	return ec.marshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, field.Selections, res)
 }
 
-""" Object describing a Post."""
-type Post {
-  id: UID!
-  createdAt: Time!
-  author: Author!
-  """ Markdown formatted content."""
-  content: String!
-  """ Other posts that the post has quoted."""
-  quotes: [Post!]
-  """ Amount of times that the post is quoted."""
-  quotedCount: Int!
-  """ The post is blocked or not."""
-  blocked: Boolean!
+func (ec *executionContext) _Post_createdAt(ctx context.Context, field graphql.CollectedField, obj *entity.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-""" PostSlice object is for selecting specific 'slice' of Post objects to
-return. Affects the returning SliceInfo."""
-type PostSlice {
-  posts: [Post!]!
-  sliceInfo: SliceInfo!
+func (ec *executionContext) _Post_author(ctx context.Context, field graphql.CollectedField, obj *entity.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Author, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.Author)
+	fc.Result = res
+	return ec.marshalNAuthor2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐAuthor(ctx, field.Selections, res)
 }
-`, BuiltIn: false},
-	&ast.Source{Name: "schema/tag.gql", Input: `extend type Query {
-  """ Main Tags."""
-  mainTags: [String!]!
-  """ Tags that are recommended."""
-  recommended: [String!]!
-  """ Searching tags by keyword."""
-  tags(
-    """ Search keyword."""
-    query: String,
-    """ Amount of tags returned."""
-    limit: Int,
-  ): [Tag!]!
+
+func (ec *executionContext) _Post_content(ctx context.Context, field graphql.CollectedField, obj *entity.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Content, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-type Tag {
-  """ Name of tag."""
-  name: String!
-  """ The tag is a MainTag if true, SubTag otherwise."""
-  isMain: Boolean!
+func (ec *executionContext) _Post_quotes(ctx context.Context, field graphql.CollectedField, obj *entity.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Post().Quotes(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*entity.Post)
+	fc.Result = res
+	return ec.marshalOPost2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostᚄ(ctx, field.Selections, res)
 }
-`, BuiltIn: false},
-	&ast.Source{Name: "schema/thread.gql", Input: `extend type Query {
-  """ A slice of Thread."""
-  threadSlice(tags: [String!], query: SliceQuery!): ThreadSlice!
-  """ A Thread object."""
-  thread(id: UID!): Thread!
+
+func (ec *executionContext) _Post_quotedCount(ctx context.Context, field graphql.CollectedField, obj *entity.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Post().QuotedCount(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-extend type Mutation {
-  """ Publish a new Thread."""
-  pubThread(thread: ThreadInput!): Thread!
-  """ Operations for moderators."""
-  lockThread(threadId: UID!): Thread!
-  """ Operations for moderators."""
-  blockThread(threadId: UID!): Thread!
-  """ Operations for moderators."""
-  editTags(threadId: UID!, mainTag: String!, subTags: [String!]!): Thread!
-}
+func (ec *executionContext) _Post_blocked(ctx context.Context, field graphql.CollectedField, obj *entity.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
 
-""" Construct a new Thread."""
-input ThreadInput {
-  """ Toggle anonymousness. If true, a new ID will be generated in each thread."""
-  anonymous: Boolean!
-  """ Markdown formmatted text. """
-  content: String!
-  """ Required. Only one mainTag is allowed."""
-  mainTag: String!
-  """ Optional, maximum of 4."""
-  subTags: [String!]
-  """ Optional. If not set, the title will be '无题'."""
-  title: String
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Blocked, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-type Thread {
-  id: UID!
-  createdAt: Time!
-  author: Author!
-  title: String
-  """ Markdown formatted content."""
-  content: String!
-  """ Only one mainTag is allowed."""
-  mainTag: String!
-  """ Optional, maximum of 4."""
-  subTags: [String!]!
-  """ Replied posts."""
-  replies(query: SliceQuery!): PostSlice!
-  """ Amount of posts replied."""
-  replyCount: Int!
-  """ A list of all posts replied in the thread. Sorted by timestamp."""
-  catalog: [ThreadCatalogItem!]
-  """ Thread is blocked."""
-  blocked: Boolean!
-  """ Thread is locked."""
-  locked: Boolean!
-}
+func (ec *executionContext) _Post_bookmarked(ctx context.Context, field graphql.CollectedField, obj *entity.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
 
-""" The ID and timestamp of post replied in the thread."""
-type ThreadCatalogItem {
-  """ The ID of post."""
-  postId: UID!
-  createdAt: Time!
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Post().Bookmarked(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-type ThreadSlice {
-  threads: [Thread!]!
-  sliceInfo: SliceInfo!
+func (ec *executionContext) _Post_editedAt(ctx context.Context, field graphql.CollectedField, obj *entity.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EditedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
-`, BuiltIn: false},
-	&ast.Source{Name: "schema/user.gql", Input: `extend type Query {
-  """ A user profile object."""
-  profile: User!
+
+func (ec *executionContext) _Post_revisions(ctx context.Context, field graphql.CollectedField, obj *entity.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Post().Revisions(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*entity.Revision)
+	fc.Result = res
+	return ec.marshalORevision2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐRevisionᚄ(ctx, field.Selections, res)
 }
 
-extend type Mutation {
-  """ Register/Login via email address.
-  An email containing sign info will be sent to the provided email address. 'redirectTo' must start as '/'.
-  If the user succeed signed in, will be go to ` + "`" + `redirectTo` + "`" + ` or ` + "`" + `/` + "`" + ` (if not specified). """
-  emailAuth(email: String!, redirectTo: String): Boolean!
-  """ Set the Name of user."""
-  setName(name: String!): User!
-  """ Directly edit tags subscribed by user."""
-  syncTags(tags: [String!]!): User!
-  """ Add tags subscribed by user."""
-  addSubbedTag(tag: String!): User!
-  """ Delete tags subscribed by user."""
-  delSubbedTag(tag: String!): User!
+func (ec *executionContext) _PostOutline_id(ctx context.Context, field graphql.CollectedField, obj *entity.PostOutline) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostOutline",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
 
-  """ Operations for moderators."""
-  banUser(postId: UID, threadId: UID): Boolean!
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uid.UID)
+	fc.Result = res
+	return ec.marshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, field.Selections, res)
 }
 
-enum Role {
-  """ Administrators """
-  admin
-  """ Moderators """
-  mod
-  """ Normal role, default value for signed up user """
-  normal
-  """ For not signed up user """
-  guest
-  """ Banned user """
-  banned
+func (ec *executionContext) _PostOutline_author(ctx context.Context, field graphql.CollectedField, obj *entity.PostOutline) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostOutline",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Author, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.Author)
+	fc.Result = res
+	return ec.marshalNAuthor2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐAuthor(ctx, field.Selections, res)
 }
 
-type User {
-  """ Only exists when the user has signed up via email. """
-  email: String
-  """ The Name of user. Required when not posting anonymously."""
-  name: String
-  """ Tags subscribed by the user."""
-  tags: [String!]
-  """ Current role of the user."""
-  role: Role!
+func (ec *executionContext) _PostOutline_content(ctx context.Context, field graphql.CollectedField, obj *entity.PostOutline) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostOutline",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
 
-  # Threads published by the user.
-  threads(query: SliceQuery!): ThreadSlice!
-  # Threads replied by the user.
-  posts(query: SliceQuery!): PostSlice!
-}
-`, BuiltIn: false},
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Content, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
-var parsedSchema = gqlparser.MustLoadSchema(sources...)
-
-// endregion ************************** generated!.gotpl **************************
 
-// region    ***************************** args.gotpl *****************************
+func (ec *executionContext) _PostSlice_posts(ctx context.Context, field graphql.CollectedField, obj *entity.PostSlice) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostSlice",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
 
-func (ec *executionContext) field_Mutation_addSubbedTag_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["tag"]; ok {
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Posts, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	args["tag"] = arg0
-	return args, nil
+	res := resTmp.([]*entity.Post)
+	fc.Result = res
+	return ec.marshalNPost2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) field_Mutation_banUser_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 *uid.UID
-	if tmp, ok := rawArgs["postId"]; ok {
-		arg0, err = ec.unmarshalOUID2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
-		if err != nil {
-			return nil, err
+func (ec *executionContext) _PostSlice_sliceInfo(ctx context.Context, field graphql.CollectedField, obj *entity.PostSlice) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostSlice",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
 	}
-	args["postId"] = arg0
-	var arg1 *uid.UID
-	if tmp, ok := rawArgs["threadId"]; ok {
-		arg1, err = ec.unmarshalOUID2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SliceInfo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	args["threadId"] = arg1
-	return args, nil
+	res := resTmp.(*entity.SliceInfo)
+	fc.Result = res
+	return ec.marshalNSliceInfo2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) field_Mutation_blockPost_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 uid.UID
-	if tmp, ok := rawArgs["postId"]; ok {
-		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
-		if err != nil {
-			return nil, err
+func (ec *executionContext) _Query_modLog(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
 	}
-	args["postId"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_Mutation_blockThread_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 uid.UID
-	if tmp, ok := rawArgs["threadId"]; ok {
-		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
-		if err != nil {
-			return nil, err
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_modLog_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ModLog(rctx, args["query"].(entity.SliceQuery))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	args["threadId"] = arg0
-	return args, nil
+	res := resTmp.(*entity.ModLogSlice)
+	fc.Result = res
+	return ec.marshalNModLogSlice2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐModLogSlice(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) field_Mutation_delSubbedTag_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["tag"]; ok {
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+func (ec *executionContext) _Query_netBans(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
 	}
-	args["tag"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_Mutation_editTags_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 uid.UID
-	if tmp, ok := rawArgs["threadId"]; ok {
-		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
-		if err != nil {
-			return nil, err
-		}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_netBans_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
 	}
-	args["threadId"] = arg0
-	var arg1 string
-	if tmp, ok := rawArgs["mainTag"]; ok {
-		arg1, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().NetBans(rctx, args["query"].(entity.SliceQuery))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	args["mainTag"] = arg1
-	var arg2 []string
-	if tmp, ok := rawArgs["subTags"]; ok {
-		arg2, err = ec.unmarshalNString2ᚕstringᚄ(ctx, tmp)
-		if err != nil {
-			return nil, err
+	res := resTmp.(*entity.NetBanSlice)
+	fc.Result = res
+	return ec.marshalNNetBanSlice2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐNetBanSlice(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_unreadNotiCount(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
 	}
-	args["subTags"] = arg2
-	return args, nil
-}
 
-func (ec *executionContext) field_Mutation_emailAuth_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["email"]; ok {
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
-		}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().UnreadNotiCount(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
 	}
-	args["email"] = arg0
-	var arg1 *string
-	if tmp, ok := rawArgs["redirectTo"]; ok {
-		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
-		if err != nil {
-			return nil, err
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	args["redirectTo"] = arg1
-	return args, nil
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) field_Mutation_lockThread_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 uid.UID
-	if tmp, ok := rawArgs["threadId"]; ok {
-		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
-		if err != nil {
-			return nil, err
+func (ec *executionContext) _Query_notification(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
 	}
-	args["threadId"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_Mutation_pubPost_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 entity.PostInput
-	if tmp, ok := rawArgs["post"]; ok {
-		arg0, err = ec.unmarshalNPostInput2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostInput(ctx, tmp)
-		if err != nil {
-			return nil, err
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_notification_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Notification(rctx, args["query"].(entity.SliceQuery))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	args["post"] = arg0
-	return args, nil
+	res := resTmp.(*entity.NotiSlice)
+	fc.Result = res
+	return ec.marshalNNotiSlice2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐNotiSlice(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) field_Mutation_pubThread_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 entity.ThreadInput
-	if tmp, ok := rawArgs["thread"]; ok {
-		arg0, err = ec.unmarshalNThreadInput2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThreadInput(ctx, tmp)
-		if err != nil {
-			return nil, err
+func (ec *executionContext) _Query_post(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
 	}
-	args["thread"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_Mutation_setName_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["name"]; ok {
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_post_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Post(rctx, args["id"].(uid.UID))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	args["name"] = arg0
-	return args, nil
+	res := resTmp.(*entity.Post)
+	fc.Result = res
+	return ec.marshalNPost2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) field_Mutation_syncTags_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 []string
-	if tmp, ok := rawArgs["tags"]; ok {
-		arg0, err = ec.unmarshalNString2ᚕstringᚄ(ctx, tmp)
-		if err != nil {
-			return nil, err
+func (ec *executionContext) _Query_reports(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
 	}
-	args["tags"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["name"]; ok {
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_reports_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Reports(rctx, args["status"].(*entity.ReportStatus), args["query"].(entity.SliceQuery))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	args["name"] = arg0
-	return args, nil
+	res := resTmp.(*entity.ReportSlice)
+	fc.Result = res
+	return ec.marshalNReportSlice2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReportSlice(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) field_Query_notification_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 entity.SliceQuery
-	if tmp, ok := rawArgs["query"]; ok {
-		arg0, err = ec.unmarshalNSliceQuery2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceQuery(ctx, tmp)
-		if err != nil {
-			return nil, err
+func (ec *executionContext) _Query_search(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
 	}
-	args["query"] = arg0
-	return args, nil
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_search_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Search(rctx, args["text"].(string), args["tags"].([]string), args["query"].(entity.SliceQuery))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*entity.SearchSlice)
+	fc.Result = res
+	return ec.marshalNSearchSlice2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSearchSlice(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) field_Query_post_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 uid.UID
-	if tmp, ok := rawArgs["id"]; ok {
-		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
-		if err != nil {
-			return nil, err
+func (ec *executionContext) _Query_mainTags(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
 	}
-	args["id"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_Query_tags_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 *string
-	if tmp, ok := rawArgs["query"]; ok {
-		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
-		if err != nil {
-			return nil, err
-		}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().MainTags(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
 	}
-	args["query"] = arg0
-	var arg1 *int
-	if tmp, ok := rawArgs["limit"]; ok {
-		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
-		if err != nil {
-			return nil, err
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	args["limit"] = arg1
-	return args, nil
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) field_Query_threadSlice_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 []string
-	if tmp, ok := rawArgs["tags"]; ok {
-		arg0, err = ec.unmarshalOString2ᚕstringᚄ(ctx, tmp)
-		if err != nil {
-			return nil, err
+func (ec *executionContext) _Query_tag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
 	}
-	args["tags"] = arg0
-	var arg1 entity.SliceQuery
-	if tmp, ok := rawArgs["query"]; ok {
-		arg1, err = ec.unmarshalNSliceQuery2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceQuery(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_tag_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Tag(rctx, args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	args["query"] = arg1
-	return args, nil
+	res := resTmp.(*entity.Tag)
+	fc.Result = res
+	return ec.marshalNTag2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐTag(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) field_Query_thread_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 uid.UID
-	if tmp, ok := rawArgs["id"]; ok {
-		arg0, err = ec.unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, tmp)
-		if err != nil {
-			return nil, err
+func (ec *executionContext) _Query_recommended(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
 	}
-	args["id"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_Thread_replies_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 entity.SliceQuery
-	if tmp, ok := rawArgs["query"]; ok {
-		arg0, err = ec.unmarshalNSliceQuery2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceQuery(ctx, tmp)
-		if err != nil {
-			return nil, err
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Recommended(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	args["query"] = arg0
-	return args, nil
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) field_User_posts_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 entity.SliceQuery
-	if tmp, ok := rawArgs["query"]; ok {
-		arg0, err = ec.unmarshalNSliceQuery2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceQuery(ctx, tmp)
-		if err != nil {
-			return nil, err
+func (ec *executionContext) _Query_tags(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
 	}
-	args["query"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_User_threads_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 entity.SliceQuery
-	if tmp, ok := rawArgs["query"]; ok {
-		arg0, err = ec.unmarshalNSliceQuery2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceQuery(ctx, tmp)
-		if err != nil {
-			return nil, err
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_tags_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Tags(rctx, args["query"].(*string), args["limit"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	args["query"] = arg0
-	return args, nil
+	res := resTmp.([]*entity.Tag)
+	fc.Result = res
+	return ec.marshalNTag2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐTagᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
-		if err != nil {
-			return nil, err
+func (ec *executionContext) _Query_trendingTags(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
 	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
-		if err != nil {
-			return nil, err
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_trendingTags_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().TrendingTags(rctx, args["window"].(entity.TrendingWindow), args["limit"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	args["includeDeprecated"] = arg0
-	return args, nil
+	res := resTmp.([]*entity.Tag)
+	fc.Result = res
+	return ec.marshalNTag2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐTagᚄ(ctx, field.Selections, res)
 }
 
-// endregion ***************************** args.gotpl *****************************
-
-// region    ************************** directives.gotpl **************************
-
-// endregion ************************** directives.gotpl **************************
-
-// region    **************************** field.gotpl *****************************
-
-func (ec *executionContext) _Author_anonymous(ctx context.Context, field graphql.CollectedField, obj *entity.Author) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_threadSlice(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1670,16 +7644,23 @@ func (ec *executionContext) _Author_anonymous(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Author",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_threadSlice_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Anonymous, nil
+		return ec.resolvers.Query().ThreadSlice(rctx, args["tags"].([]string), args["sort"].(*entity.ThreadSort), args["query"].(entity.SliceQuery))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1691,12 +7672,12 @@ func (ec *executionContext) _Author_anonymous(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*entity.ThreadSlice)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNThreadSlice2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThreadSlice(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Author_author(ctx context.Context, field graphql.CollectedField, obj *entity.Author) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_timeline(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1704,16 +7685,23 @@ func (ec *executionContext) _Author_author(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Author",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_timeline_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Author, nil
+		return ec.resolvers.Query().Timeline(rctx, args["query"].(entity.SliceQuery))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1725,12 +7713,12 @@ func (ec *executionContext) _Author_author(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*entity.ThreadSlice)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNThreadSlice2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThreadSlice(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_pubPost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_thread(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1738,7 +7726,7 @@ func (ec *executionContext) _Mutation_pubPost(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -1746,7 +7734,7 @@ func (ec *executionContext) _Mutation_pubPost(ctx context.Context, field graphql
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_pubPost_args(ctx, rawArgs)
+	args, err := ec.field_Query_thread_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -1754,7 +7742,7 @@ func (ec *executionContext) _Mutation_pubPost(ctx context.Context, field graphql
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().PubPost(rctx, args["post"].(entity.PostInput))
+		return ec.resolvers.Query().Thread(rctx, args["id"].(uid.UID))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1766,12 +7754,12 @@ func (ec *executionContext) _Mutation_pubPost(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.Post)
+	res := resTmp.(*entity.Thread)
 	fc.Result = res
-	return ec.marshalNPost2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPost(ctx, field.Selections, res)
+	return ec.marshalNThread2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThread(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_blockPost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_profile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1779,23 +7767,16 @@ func (ec *executionContext) _Mutation_blockPost(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_blockPost_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().BlockPost(rctx, args["postId"].(uid.UID))
+		return ec.resolvers.Query().Profile(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1807,12 +7788,12 @@ func (ec *executionContext) _Mutation_blockPost(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.Post)
+	res := resTmp.(*entity.User)
 	fc.Result = res
-	return ec.marshalNPost2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPost(ctx, field.Selections, res)
+	return ec.marshalNUser2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐUser(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_pubThread(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1820,7 +7801,7 @@ func (ec *executionContext) _Mutation_pubThread(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -1828,7 +7809,7 @@ func (ec *executionContext) _Mutation_pubThread(ctx context.Context, field graph
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_pubThread_args(ctx, rawArgs)
+	args, err := ec.field_Query___type_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -1836,24 +7817,21 @@ func (ec *executionContext) _Mutation_pubThread(ctx context.Context, field graph
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().PubThread(rctx, args["thread"].(entity.ThreadInput))
+		return ec.introspectType(args["name"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.Thread)
+	res := resTmp.(*introspection.Type)
 	fc.Result = res
-	return ec.marshalNThread2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThread(ctx, field.Selections, res)
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_lockThread(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1861,23 +7839,47 @@ func (ec *executionContext) _Mutation_lockThread(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_lockThread_args(ctx, rawArgs)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectSchema()
+	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
-	fc.Args = args
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Schema)
+	fc.Result = res
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _QuotedNoti_threadId(ctx context.Context, field graphql.CollectedField, obj *entity.QuotedNoti) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "QuotedNoti",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().LockThread(rctx, args["threadId"].(uid.UID))
+		return obj.ThreadID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1889,12 +7891,12 @@ func (ec *executionContext) _Mutation_lockThread(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.Thread)
+	res := resTmp.(uid.UID)
 	fc.Result = res
-	return ec.marshalNThread2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThread(ctx, field.Selections, res)
+	return ec.marshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_blockThread(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _QuotedNoti_quotedPost(ctx context.Context, field graphql.CollectedField, obj *entity.QuotedNoti) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1902,23 +7904,16 @@ func (ec *executionContext) _Mutation_blockThread(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "QuotedNoti",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_blockThread_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().BlockThread(rctx, args["threadId"].(uid.UID))
+		return obj.QuotedPost, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1930,12 +7925,12 @@ func (ec *executionContext) _Mutation_blockThread(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.Thread)
+	res := resTmp.(*entity.PostOutline)
 	fc.Result = res
-	return ec.marshalNThread2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThread(ctx, field.Selections, res)
+	return ec.marshalNPostOutline2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostOutline(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_editTags(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _QuotedNoti_post(ctx context.Context, field graphql.CollectedField, obj *entity.QuotedNoti) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1943,23 +7938,16 @@ func (ec *executionContext) _Mutation_editTags(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "QuotedNoti",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_editTags_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().EditTags(rctx, args["threadId"].(uid.UID), args["mainTag"].(string), args["subTags"].([]string))
+		return obj.Post, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1971,12 +7959,12 @@ func (ec *executionContext) _Mutation_editTags(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.Thread)
+	res := resTmp.(*entity.PostOutline)
 	fc.Result = res
-	return ec.marshalNThread2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThread(ctx, field.Selections, res)
+	return ec.marshalNPostOutline2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostOutline(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_emailAuth(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _RepliedNoti_thread(ctx context.Context, field graphql.CollectedField, obj *entity.RepliedNoti) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1984,23 +7972,16 @@ func (ec *executionContext) _Mutation_emailAuth(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "RepliedNoti",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_emailAuth_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().EmailAuth(rctx, args["email"].(string), args["redirectTo"].(*string))
+		return obj.Thread, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2012,12 +7993,12 @@ func (ec *executionContext) _Mutation_emailAuth(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*entity.ThreadOutline)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNThreadOutline2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThreadOutline(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_setName(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _RepliedNoti_newRepliesCount(ctx context.Context, field graphql.CollectedField, obj *entity.RepliedNoti) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2025,23 +8006,16 @@ func (ec *executionContext) _Mutation_setName(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "RepliedNoti",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_setName_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().SetName(rctx, args["name"].(string))
+		return obj.NewRepliesCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2053,12 +8027,12 @@ func (ec *executionContext) _Mutation_setName(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.User)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNUser2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐUser(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_syncTags(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _RepliedNoti_firstReplyId(ctx context.Context, field graphql.CollectedField, obj *entity.RepliedNoti) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2066,23 +8040,16 @@ func (ec *executionContext) _Mutation_syncTags(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "RepliedNoti",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_syncTags_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().SyncTags(rctx, args["tags"].([]string))
+		return obj.FirstReplyID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2094,12 +8061,12 @@ func (ec *executionContext) _Mutation_syncTags(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.User)
+	res := resTmp.(uid.UID)
 	fc.Result = res
-	return ec.marshalNUser2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐUser(ctx, field.Selections, res)
+	return ec.marshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_addSubbedTag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Report_id(ctx context.Context, field graphql.CollectedField, obj *entity.Report) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2107,23 +8074,16 @@ func (ec *executionContext) _Mutation_addSubbedTag(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "Report",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_addSubbedTag_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().AddSubbedTag(rctx, args["tag"].(string))
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2135,12 +8095,12 @@ func (ec *executionContext) _Mutation_addSubbedTag(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.User)
+	res := resTmp.(uid.UID)
 	fc.Result = res
-	return ec.marshalNUser2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐUser(ctx, field.Selections, res)
+	return ec.marshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_delSubbedTag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Report_createdAt(ctx context.Context, field graphql.CollectedField, obj *entity.Report) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2148,23 +8108,50 @@ func (ec *executionContext) _Mutation_delSubbedTag(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "Report",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_delSubbedTag_args(ctx, rawArgs)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
-	fc.Args = args
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Report_reason(ctx context.Context, field graphql.CollectedField, obj *entity.Report) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Report",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().DelSubbedTag(rctx, args["tag"].(string))
+		return obj.Reason, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2176,12 +8163,12 @@ func (ec *executionContext) _Mutation_delSubbedTag(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.User)
+	res := resTmp.(entity.ReportReason)
 	fc.Result = res
-	return ec.marshalNUser2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐUser(ctx, field.Selections, res)
+	return ec.marshalNReportReason2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReportReason(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_banUser(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Report_text(ctx context.Context, field graphql.CollectedField, obj *entity.Report) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2189,40 +8176,30 @@ func (ec *executionContext) _Mutation_banUser(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "Report",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_banUser_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().BanUser(rctx, args["postId"].(*uid.UID), args["threadId"].(*uid.UID))
+		return obj.Text, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _NotiSlice_notifications(ctx context.Context, field graphql.CollectedField, obj *entity.NotiSlice) (ret graphql.Marshaler) {
+func (ec *executionContext) _Report_status(ctx context.Context, field graphql.CollectedField, obj *entity.Report) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2230,7 +8207,7 @@ func (ec *executionContext) _NotiSlice_notifications(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "NotiSlice",
+		Object:   "Report",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2239,7 +8216,7 @@ func (ec *executionContext) _NotiSlice_notifications(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Notifications, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2251,12 +8228,12 @@ func (ec *executionContext) _NotiSlice_notifications(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*entity.Notification)
+	res := resTmp.(entity.ReportStatus)
 	fc.Result = res
-	return ec.marshalNNotification2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐNotificationᚄ(ctx, field.Selections, res)
+	return ec.marshalNReportStatus2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReportStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _NotiSlice_sliceInfo(ctx context.Context, field graphql.CollectedField, obj *entity.NotiSlice) (ret graphql.Marshaler) {
+func (ec *executionContext) _Report_handledAt(ctx context.Context, field graphql.CollectedField, obj *entity.Report) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2264,7 +8241,7 @@ func (ec *executionContext) _NotiSlice_sliceInfo(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "NotiSlice",
+		Object:   "Report",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2273,24 +8250,21 @@ func (ec *executionContext) _NotiSlice_sliceInfo(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SliceInfo, nil
+		return obj.HandledAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.SliceInfo)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNSliceInfo2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceInfo(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Notification_type(ctx context.Context, field graphql.CollectedField, obj *entity.Notification) (ret graphql.Marshaler) {
+func (ec *executionContext) _Report_thread(ctx context.Context, field graphql.CollectedField, obj *entity.Report) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2298,16 +8272,16 @@ func (ec *executionContext) _Notification_type(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Notification",
+		Object:   "Report",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return ec.resolvers.Report().Thread(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2319,12 +8293,12 @@ func (ec *executionContext) _Notification_type(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(entity.NotiType)
+	res := resTmp.(*entity.Thread)
 	fc.Result = res
-	return ec.marshalNNotiType2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐNotiType(ctx, field.Selections, res)
+	return ec.marshalNThread2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThread(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Notification_eventTime(ctx context.Context, field graphql.CollectedField, obj *entity.Notification) (ret graphql.Marshaler) {
+func (ec *executionContext) _Report_post(ctx context.Context, field graphql.CollectedField, obj *entity.Report) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2332,33 +8306,30 @@ func (ec *executionContext) _Notification_eventTime(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Notification",
+		Object:   "Report",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EventTime, nil
+		return ec.resolvers.Report().Post(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*entity.Post)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOPost2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Notification_hasRead(ctx context.Context, field graphql.CollectedField, obj *entity.Notification) (ret graphql.Marshaler) {
+func (ec *executionContext) _ReportSlice_reports(ctx context.Context, field graphql.CollectedField, obj *entity.ReportSlice) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2366,7 +8337,7 @@ func (ec *executionContext) _Notification_hasRead(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Notification",
+		Object:   "ReportSlice",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2375,7 +8346,7 @@ func (ec *executionContext) _Notification_hasRead(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HasRead, nil
+		return obj.Reports, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2387,12 +8358,12 @@ func (ec *executionContext) _Notification_hasRead(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.([]*entity.Report)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNReport2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReportᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Notification_content(ctx context.Context, field graphql.CollectedField, obj *entity.Notification) (ret graphql.Marshaler) {
+func (ec *executionContext) _ReportSlice_sliceInfo(ctx context.Context, field graphql.CollectedField, obj *entity.ReportSlice) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2400,7 +8371,7 @@ func (ec *executionContext) _Notification_content(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Notification",
+		Object:   "ReportSlice",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2409,7 +8380,7 @@ func (ec *executionContext) _Notification_content(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Content, nil
+		return obj.SliceInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2421,12 +8392,12 @@ func (ec *executionContext) _Notification_content(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(entity.NotiContent)
+	res := resTmp.(*entity.SliceInfo)
 	fc.Result = res
-	return ec.marshalNNotiContent2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐNotiContent(ctx, field.Selections, res)
+	return ec.marshalNSliceInfo2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Post_id(ctx context.Context, field graphql.CollectedField, obj *entity.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _Revision_createdAt(ctx context.Context, field graphql.CollectedField, obj *entity.Revision) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2434,7 +8405,7 @@ func (ec *executionContext) _Post_id(ctx context.Context, field graphql.Collecte
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Post",
+		Object:   "Revision",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2443,7 +8414,7 @@ func (ec *executionContext) _Post_id(ctx context.Context, field graphql.Collecte
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2455,12 +8426,12 @@ func (ec *executionContext) _Post_id(ctx context.Context, field graphql.Collecte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uid.UID)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Post_createdAt(ctx context.Context, field graphql.CollectedField, obj *entity.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _Revision_title(ctx context.Context, field graphql.CollectedField, obj *entity.Revision) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2468,7 +8439,7 @@ func (ec *executionContext) _Post_createdAt(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Post",
+		Object:   "Revision",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2477,24 +8448,21 @@ func (ec *executionContext) _Post_createdAt(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.Title, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Post_author(ctx context.Context, field graphql.CollectedField, obj *entity.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _Revision_content(ctx context.Context, field graphql.CollectedField, obj *entity.Revision) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2502,7 +8470,7 @@ func (ec *executionContext) _Post_author(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Post",
+		Object:   "Revision",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2511,7 +8479,7 @@ func (ec *executionContext) _Post_author(ctx context.Context, field graphql.Coll
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Author, nil
+		return obj.Content, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2523,12 +8491,12 @@ func (ec *executionContext) _Post_author(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.Author)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNAuthor2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐAuthor(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Post_content(ctx context.Context, field graphql.CollectedField, obj *entity.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _SearchSlice_items(ctx context.Context, field graphql.CollectedField, obj *entity.SearchSlice) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2536,7 +8504,7 @@ func (ec *executionContext) _Post_content(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Post",
+		Object:   "SearchSlice",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2545,7 +8513,7 @@ func (ec *executionContext) _Post_content(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Content, nil
+		return obj.Items, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2557,12 +8525,12 @@ func (ec *executionContext) _Post_content(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]entity.SearchItem)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNSearchItem2ᚕgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSearchItemᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Post_quotes(ctx context.Context, field graphql.CollectedField, obj *entity.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _SearchSlice_sliceInfo(ctx context.Context, field graphql.CollectedField, obj *entity.SearchSlice) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2570,30 +8538,33 @@ func (ec *executionContext) _Post_quotes(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Post",
+		Object:   "SearchSlice",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Post().Quotes(rctx, obj)
+		return obj.SliceInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*entity.Post)
+	res := resTmp.(*entity.SliceInfo)
 	fc.Result = res
-	return ec.marshalOPost2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostᚄ(ctx, field.Selections, res)
+	return ec.marshalNSliceInfo2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Post_quotedCount(ctx context.Context, field graphql.CollectedField, obj *entity.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _SliceInfo_firstCursor(ctx context.Context, field graphql.CollectedField, obj *entity.SliceInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2601,16 +8572,16 @@ func (ec *executionContext) _Post_quotedCount(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Post",
+		Object:   "SliceInfo",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Post().QuotedCount(rctx, obj)
+		return obj.FirstCursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2622,12 +8593,12 @@ func (ec *executionContext) _Post_quotedCount(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Post_blocked(ctx context.Context, field graphql.CollectedField, obj *entity.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _SliceInfo_lastCursor(ctx context.Context, field graphql.CollectedField, obj *entity.SliceInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2635,7 +8606,7 @@ func (ec *executionContext) _Post_blocked(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Post",
+		Object:   "SliceInfo",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2644,7 +8615,7 @@ func (ec *executionContext) _Post_blocked(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Blocked, nil
+		return obj.LastCursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2656,12 +8627,12 @@ func (ec *executionContext) _Post_blocked(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _PostOutline_id(ctx context.Context, field graphql.CollectedField, obj *entity.PostOutline) (ret graphql.Marshaler) {
+func (ec *executionContext) _SliceInfo_hasNext(ctx context.Context, field graphql.CollectedField, obj *entity.SliceInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2669,7 +8640,7 @@ func (ec *executionContext) _PostOutline_id(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "PostOutline",
+		Object:   "SliceInfo",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2678,7 +8649,7 @@ func (ec *executionContext) _PostOutline_id(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.HasNext, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2690,80 +8661,107 @@ func (ec *executionContext) _PostOutline_id(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uid.UID)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _PostOutline_author(ctx context.Context, field graphql.CollectedField, obj *entity.PostOutline) (ret graphql.Marshaler) {
+func (ec *executionContext) _Subscription_unreadNoti(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			ret = nil
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "PostOutline",
+		Object:   "Subscription",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Author, nil
+		return ec.resolvers.Subscription().UnreadNoti(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return graphql.Null
+		return nil
 	}
 	if resTmp == nil {
 		if !graphql.HasFieldError(ctx, fc) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return graphql.Null
+		return nil
+	}
+	return func() graphql.Marshaler {
+		res, ok := <-resTmp.(<-chan *entity.UnreadNotiEvent)
+		if !ok {
+			return nil
+		}
+		return graphql.WriterFunc(func(w io.Writer) {
+			w.Write([]byte{'{'})
+			graphql.MarshalString(field.Alias).MarshalGQL(w)
+			w.Write([]byte{':'})
+			ec.marshalNUnreadNotiEvent2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐUnreadNotiEvent(ctx, field.Selections, res).MarshalGQL(w)
+			w.Write([]byte{'}'})
+		})
 	}
-	res := resTmp.(*entity.Author)
-	fc.Result = res
-	return ec.marshalNAuthor2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐAuthor(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _PostOutline_content(ctx context.Context, field graphql.CollectedField, obj *entity.PostOutline) (ret graphql.Marshaler) {
+func (ec *executionContext) _Subscription_threadReplies(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			ret = nil
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "PostOutline",
+		Object:   "Subscription",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Subscription_threadReplies_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Content, nil
+		return ec.resolvers.Subscription().ThreadReplies(rctx, args["threadId"].(uid.UID))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return graphql.Null
+		return nil
 	}
 	if resTmp == nil {
 		if !graphql.HasFieldError(ctx, fc) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return graphql.Null
+		return nil
+	}
+	return func() graphql.Marshaler {
+		res, ok := <-resTmp.(<-chan *entity.Post)
+		if !ok {
+			return nil
+		}
+		return graphql.WriterFunc(func(w io.Writer) {
+			w.Write([]byte{'{'})
+			graphql.MarshalString(field.Alias).MarshalGQL(w)
+			w.Write([]byte{':'})
+			ec.marshalNPost2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPost(ctx, field.Selections, res).MarshalGQL(w)
+			w.Write([]byte{'}'})
+		})
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _PostSlice_posts(ctx context.Context, field graphql.CollectedField, obj *entity.PostSlice) (ret graphql.Marshaler) {
+func (ec *executionContext) _SystemNoti_title(ctx context.Context, field graphql.CollectedField, obj *entity.SystemNoti) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2771,7 +8769,7 @@ func (ec *executionContext) _PostSlice_posts(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "PostSlice",
+		Object:   "SystemNoti",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2780,7 +8778,7 @@ func (ec *executionContext) _PostSlice_posts(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Posts, nil
+		return obj.Title, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2792,12 +8790,12 @@ func (ec *executionContext) _PostSlice_posts(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*entity.Post)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNPost2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _PostSlice_sliceInfo(ctx context.Context, field graphql.CollectedField, obj *entity.PostSlice) (ret graphql.Marshaler) {
+func (ec *executionContext) _SystemNoti_content(ctx context.Context, field graphql.CollectedField, obj *entity.SystemNoti) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2805,7 +8803,7 @@ func (ec *executionContext) _PostSlice_sliceInfo(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "PostSlice",
+		Object:   "SystemNoti",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2814,7 +8812,7 @@ func (ec *executionContext) _PostSlice_sliceInfo(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SliceInfo, nil
+		return obj.Content, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2826,12 +8824,12 @@ func (ec *executionContext) _PostSlice_sliceInfo(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.SliceInfo)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNSliceInfo2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceInfo(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_unreadNotiCount(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Tag_name(ctx context.Context, field graphql.CollectedField, obj *entity.Tag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2839,16 +8837,16 @@ func (ec *executionContext) _Query_unreadNotiCount(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "Tag",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().UnreadNotiCount(rctx)
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2860,12 +8858,12 @@ func (ec *executionContext) _Query_unreadNotiCount(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_notification(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Tag_isMain(ctx context.Context, field graphql.CollectedField, obj *entity.Tag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2873,23 +8871,16 @@ func (ec *executionContext) _Query_notification(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "Tag",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_notification_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Notification(rctx, args["query"].(entity.SliceQuery))
+		return obj.IsMain, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2901,12 +8892,12 @@ func (ec *executionContext) _Query_notification(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.NotiSlice)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNNotiSlice2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐNotiSlice(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_post(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Tag_description(ctx context.Context, field graphql.CollectedField, obj *entity.Tag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2914,40 +8905,30 @@ func (ec *executionContext) _Query_post(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "Tag",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_post_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Post(rctx, args["id"].(uid.UID))
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.Post)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNPost2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPost(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_mainTags(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Tag_rules(ctx context.Context, field graphql.CollectedField, obj *entity.Tag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2955,33 +8936,30 @@ func (ec *executionContext) _Query_mainTags(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "Tag",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().MainTags(rctx)
+		return obj.Rules, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
+	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_recommended(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Tag_threadCount(ctx context.Context, field graphql.CollectedField, obj *entity.Tag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2989,16 +8967,16 @@ func (ec *executionContext) _Query_recommended(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "Tag",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Recommended(rctx)
+		return obj.ThreadCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3010,12 +8988,12 @@ func (ec *executionContext) _Query_recommended(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_tags(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Tag_subscriberCount(ctx context.Context, field graphql.CollectedField, obj *entity.Tag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3023,23 +9001,16 @@ func (ec *executionContext) _Query_tags(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "Tag",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_tags_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Tags(rctx, args["query"].(*string), args["limit"].(*int))
+		return obj.SubscriberCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3051,12 +9022,12 @@ func (ec *executionContext) _Query_tags(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*entity.Tag)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNTag2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐTagᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_threadSlice(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Tag_activeAt(ctx context.Context, field graphql.CollectedField, obj *entity.Tag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3064,40 +9035,30 @@ func (ec *executionContext) _Query_threadSlice(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "Tag",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_threadSlice_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().ThreadSlice(rctx, args["tags"].([]string), args["query"].(entity.SliceQuery))
+		return obj.ActiveAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.ThreadSlice)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNThreadSlice2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThreadSlice(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_thread(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _TagRole_tag(ctx context.Context, field graphql.CollectedField, obj *entity.TagRole) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3105,23 +9066,16 @@ func (ec *executionContext) _Query_thread(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "TagRole",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_thread_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Thread(rctx, args["id"].(uid.UID))
+		return obj.Tag, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3133,12 +9087,12 @@ func (ec *executionContext) _Query_thread(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.Thread)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNThread2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThread(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_profile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _TagRole_role(ctx context.Context, field graphql.CollectedField, obj *entity.TagRole) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3146,16 +9100,16 @@ func (ec *executionContext) _Query_profile(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "TagRole",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Profile(rctx)
+		return obj.Role, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3167,12 +9121,12 @@ func (ec *executionContext) _Query_profile(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.User)
+	res := resTmp.(entity.Role)
 	fc.Result = res
-	return ec.marshalNUser2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐUser(ctx, field.Selections, res)
+	return ec.marshalNRole2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐRole(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Thread_id(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3180,37 +9134,33 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "Thread",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query___type_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(args["name"].(string))
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(uid.UID)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Thread_createdAt(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3218,30 +9168,33 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "Thread",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _QuotedNoti_threadId(ctx context.Context, field graphql.CollectedField, obj *entity.QuotedNoti) (ret graphql.Marshaler) {
+func (ec *executionContext) _Thread_author(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3249,7 +9202,7 @@ func (ec *executionContext) _QuotedNoti_threadId(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "QuotedNoti",
+		Object:   "Thread",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3258,7 +9211,7 @@ func (ec *executionContext) _QuotedNoti_threadId(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ThreadID, nil
+		return obj.Author, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3270,12 +9223,12 @@ func (ec *executionContext) _QuotedNoti_threadId(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uid.UID)
+	res := resTmp.(*entity.Author)
 	fc.Result = res
-	return ec.marshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, field.Selections, res)
+	return ec.marshalNAuthor2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐAuthor(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _QuotedNoti_quotedPost(ctx context.Context, field graphql.CollectedField, obj *entity.QuotedNoti) (ret graphql.Marshaler) {
+func (ec *executionContext) _Thread_title(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3283,7 +9236,7 @@ func (ec *executionContext) _QuotedNoti_quotedPost(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "QuotedNoti",
+		Object:   "Thread",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3292,24 +9245,21 @@ func (ec *executionContext) _QuotedNoti_quotedPost(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.QuotedPost, nil
+		return obj.Title, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.PostOutline)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNPostOutline2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostOutline(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _QuotedNoti_post(ctx context.Context, field graphql.CollectedField, obj *entity.QuotedNoti) (ret graphql.Marshaler) {
+func (ec *executionContext) _Thread_content(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3317,7 +9267,7 @@ func (ec *executionContext) _QuotedNoti_post(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "QuotedNoti",
+		Object:   "Thread",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3326,7 +9276,7 @@ func (ec *executionContext) _QuotedNoti_post(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Post, nil
+		return obj.Content, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3338,12 +9288,12 @@ func (ec *executionContext) _QuotedNoti_post(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.PostOutline)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNPostOutline2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostOutline(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RepliedNoti_thread(ctx context.Context, field graphql.CollectedField, obj *entity.RepliedNoti) (ret graphql.Marshaler) {
+func (ec *executionContext) _Thread_mainTag(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3351,7 +9301,7 @@ func (ec *executionContext) _RepliedNoti_thread(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "RepliedNoti",
+		Object:   "Thread",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3360,7 +9310,7 @@ func (ec *executionContext) _RepliedNoti_thread(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Thread, nil
+		return obj.MainTag, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3372,12 +9322,12 @@ func (ec *executionContext) _RepliedNoti_thread(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.ThreadOutline)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNThreadOutline2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThreadOutline(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RepliedNoti_newRepliesCount(ctx context.Context, field graphql.CollectedField, obj *entity.RepliedNoti) (ret graphql.Marshaler) {
+func (ec *executionContext) _Thread_subTags(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3385,7 +9335,7 @@ func (ec *executionContext) _RepliedNoti_newRepliesCount(ctx context.Context, fi
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "RepliedNoti",
+		Object:   "Thread",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3394,7 +9344,7 @@ func (ec *executionContext) _RepliedNoti_newRepliesCount(ctx context.Context, fi
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.NewRepliesCount, nil
+		return obj.SubTags, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3406,12 +9356,12 @@ func (ec *executionContext) _RepliedNoti_newRepliesCount(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RepliedNoti_firstReplyId(ctx context.Context, field graphql.CollectedField, obj *entity.RepliedNoti) (ret graphql.Marshaler) {
+func (ec *executionContext) _Thread_replies(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3419,16 +9369,23 @@ func (ec *executionContext) _RepliedNoti_firstReplyId(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "RepliedNoti",
+		Object:   "Thread",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Thread_replies_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FirstReplyID, nil
+		return ec.resolvers.Thread().Replies(rctx, obj, args["query"].(entity.SliceQuery))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3440,12 +9397,12 @@ func (ec *executionContext) _RepliedNoti_firstReplyId(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uid.UID)
+	res := resTmp.(*entity.PostSlice)
 	fc.Result = res
-	return ec.marshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, field.Selections, res)
+	return ec.marshalNPostSlice2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostSlice(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _SliceInfo_firstCursor(ctx context.Context, field graphql.CollectedField, obj *entity.SliceInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _Thread_replyCount(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3453,16 +9410,16 @@ func (ec *executionContext) _SliceInfo_firstCursor(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "SliceInfo",
+		Object:   "Thread",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FirstCursor, nil
+		return ec.resolvers.Thread().ReplyCount(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3474,12 +9431,12 @@ func (ec *executionContext) _SliceInfo_firstCursor(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _SliceInfo_lastCursor(ctx context.Context, field graphql.CollectedField, obj *entity.SliceInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _Thread_catalog(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3487,33 +9444,30 @@ func (ec *executionContext) _SliceInfo_lastCursor(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "SliceInfo",
+		Object:   "Thread",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LastCursor, nil
+		return ec.resolvers.Thread().Catalog(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*entity.ThreadCatalogItem)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOThreadCatalogItem2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThreadCatalogItemᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _SliceInfo_hasNext(ctx context.Context, field graphql.CollectedField, obj *entity.SliceInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _Thread_watched(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3521,16 +9475,16 @@ func (ec *executionContext) _SliceInfo_hasNext(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "SliceInfo",
+		Object:   "Thread",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HasNext, nil
+		return ec.resolvers.Thread().Watched(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3547,7 +9501,7 @@ func (ec *executionContext) _SliceInfo_hasNext(ctx context.Context, field graphq
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _SystemNoti_title(ctx context.Context, field graphql.CollectedField, obj *entity.SystemNoti) (ret graphql.Marshaler) {
+func (ec *executionContext) _Thread_bookmarked(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3555,16 +9509,16 @@ func (ec *executionContext) _SystemNoti_title(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "SystemNoti",
+		Object:   "Thread",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Title, nil
+		return ec.resolvers.Thread().Bookmarked(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3576,12 +9530,12 @@ func (ec *executionContext) _SystemNoti_title(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _SystemNoti_content(ctx context.Context, field graphql.CollectedField, obj *entity.SystemNoti) (ret graphql.Marshaler) {
+func (ec *executionContext) _Thread_blocked(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3589,7 +9543,7 @@ func (ec *executionContext) _SystemNoti_content(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "SystemNoti",
+		Object:   "Thread",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3598,7 +9552,7 @@ func (ec *executionContext) _SystemNoti_content(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Content, nil
+		return obj.Blocked, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3610,12 +9564,12 @@ func (ec *executionContext) _SystemNoti_content(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Tag_name(ctx context.Context, field graphql.CollectedField, obj *entity.Tag) (ret graphql.Marshaler) {
+func (ec *executionContext) _Thread_locked(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3623,7 +9577,7 @@ func (ec *executionContext) _Tag_name(ctx context.Context, field graphql.Collect
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Tag",
+		Object:   "Thread",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3632,7 +9586,7 @@ func (ec *executionContext) _Tag_name(ctx context.Context, field graphql.Collect
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Locked, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3644,12 +9598,12 @@ func (ec *executionContext) _Tag_name(ctx context.Context, field graphql.Collect
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Tag_isMain(ctx context.Context, field graphql.CollectedField, obj *entity.Tag) (ret graphql.Marshaler) {
+func (ec *executionContext) _Thread_pinned(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3657,7 +9611,7 @@ func (ec *executionContext) _Tag_isMain(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Tag",
+		Object:   "Thread",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3666,7 +9620,7 @@ func (ec *executionContext) _Tag_isMain(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsMain, nil
+		return obj.Pinned, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3683,7 +9637,7 @@ func (ec *executionContext) _Tag_isMain(ctx context.Context, field graphql.Colle
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Thread_id(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
+func (ec *executionContext) _Thread_pinnedUntil(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3700,24 +9654,21 @@ func (ec *executionContext) _Thread_id(ctx context.Context, field graphql.Collec
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.PinnedUntil, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(uid.UID)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Thread_createdAt(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
+func (ec *executionContext) _Thread_editedAt(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3734,24 +9685,21 @@ func (ec *executionContext) _Thread_createdAt(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.EditedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Thread_author(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
+func (ec *executionContext) _Thread_revisions(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3762,30 +9710,27 @@ func (ec *executionContext) _Thread_author(ctx context.Context, field graphql.Co
 		Object:   "Thread",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Author, nil
+		return ec.resolvers.Thread().Revisions(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.Author)
+	res := resTmp.([]*entity.Revision)
 	fc.Result = res
-	return ec.marshalNAuthor2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐAuthor(ctx, field.Selections, res)
+	return ec.marshalORevision2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐRevisionᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Thread_title(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
+func (ec *executionContext) _ThreadCatalogItem_postId(ctx context.Context, field graphql.CollectedField, obj *entity.ThreadCatalogItem) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3793,7 +9738,7 @@ func (ec *executionContext) _Thread_title(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Thread",
+		Object:   "ThreadCatalogItem",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3802,21 +9747,24 @@ func (ec *executionContext) _Thread_title(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Title, nil
+		return obj.PostID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(uid.UID)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Thread_content(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
+func (ec *executionContext) _ThreadCatalogItem_createdAt(ctx context.Context, field graphql.CollectedField, obj *entity.ThreadCatalogItem) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3824,7 +9772,7 @@ func (ec *executionContext) _Thread_content(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Thread",
+		Object:   "ThreadCatalogItem",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3833,7 +9781,7 @@ func (ec *executionContext) _Thread_content(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Content, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3845,12 +9793,12 @@ func (ec *executionContext) _Thread_content(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Thread_mainTag(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
+func (ec *executionContext) _ThreadOutline_id(ctx context.Context, field graphql.CollectedField, obj *entity.ThreadOutline) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3858,7 +9806,7 @@ func (ec *executionContext) _Thread_mainTag(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Thread",
+		Object:   "ThreadOutline",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3867,7 +9815,7 @@ func (ec *executionContext) _Thread_mainTag(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MainTag, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3879,12 +9827,12 @@ func (ec *executionContext) _Thread_mainTag(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(uid.UID)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Thread_subTags(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
+func (ec *executionContext) _ThreadOutline_title(ctx context.Context, field graphql.CollectedField, obj *entity.ThreadOutline) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3892,7 +9840,7 @@ func (ec *executionContext) _Thread_subTags(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Thread",
+		Object:   "ThreadOutline",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3901,24 +9849,21 @@ func (ec *executionContext) _Thread_subTags(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SubTags, nil
+		return obj.Title, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Thread_replies(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
+func (ec *executionContext) _ThreadOutline_content(ctx context.Context, field graphql.CollectedField, obj *entity.ThreadOutline) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3926,23 +9871,16 @@ func (ec *executionContext) _Thread_replies(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Thread",
+		Object:   "ThreadOutline",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Thread_replies_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Thread().Replies(rctx, obj, args["query"].(entity.SliceQuery))
+		return obj.Content, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3954,12 +9892,12 @@ func (ec *executionContext) _Thread_replies(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.PostSlice)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNPostSlice2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostSlice(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Thread_replyCount(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
+func (ec *executionContext) _ThreadOutline_mainTag(ctx context.Context, field graphql.CollectedField, obj *entity.ThreadOutline) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3967,16 +9905,16 @@ func (ec *executionContext) _Thread_replyCount(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Thread",
+		Object:   "ThreadOutline",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Thread().ReplyCount(rctx, obj)
+		return obj.MainTag, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3988,12 +9926,12 @@ func (ec *executionContext) _Thread_replyCount(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Thread_catalog(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
+func (ec *executionContext) _ThreadOutline_subTags(ctx context.Context, field graphql.CollectedField, obj *entity.ThreadOutline) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4001,30 +9939,33 @@ func (ec *executionContext) _Thread_catalog(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Thread",
+		Object:   "ThreadOutline",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Thread().Catalog(rctx, obj)
+		return obj.SubTags, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*entity.ThreadCatalogItem)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalOThreadCatalogItem2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThreadCatalogItemᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Thread_blocked(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
+func (ec *executionContext) _ThreadSlice_threads(ctx context.Context, field graphql.CollectedField, obj *entity.ThreadSlice) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4032,7 +9973,7 @@ func (ec *executionContext) _Thread_blocked(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Thread",
+		Object:   "ThreadSlice",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4041,7 +9982,7 @@ func (ec *executionContext) _Thread_blocked(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Blocked, nil
+		return obj.Threads, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4053,12 +9994,12 @@ func (ec *executionContext) _Thread_blocked(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.([]*entity.Thread)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNThread2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThreadᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Thread_locked(ctx context.Context, field graphql.CollectedField, obj *entity.Thread) (ret graphql.Marshaler) {
+func (ec *executionContext) _ThreadSlice_sliceInfo(ctx context.Context, field graphql.CollectedField, obj *entity.ThreadSlice) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4066,7 +10007,7 @@ func (ec *executionContext) _Thread_locked(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Thread",
+		Object:   "ThreadSlice",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4075,7 +10016,7 @@ func (ec *executionContext) _Thread_locked(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Locked, nil
+		return obj.SliceInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4087,12 +10028,12 @@ func (ec *executionContext) _Thread_locked(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*entity.SliceInfo)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNSliceInfo2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ThreadCatalogItem_postId(ctx context.Context, field graphql.CollectedField, obj *entity.ThreadCatalogItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _UnreadNotiEvent_unreadCount(ctx context.Context, field graphql.CollectedField, obj *entity.UnreadNotiEvent) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4100,7 +10041,7 @@ func (ec *executionContext) _ThreadCatalogItem_postId(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ThreadCatalogItem",
+		Object:   "UnreadNotiEvent",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4109,7 +10050,7 @@ func (ec *executionContext) _ThreadCatalogItem_postId(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PostID, nil
+		return obj.UnreadCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4121,12 +10062,12 @@ func (ec *executionContext) _ThreadCatalogItem_postId(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uid.UID)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ThreadCatalogItem_createdAt(ctx context.Context, field graphql.CollectedField, obj *entity.ThreadCatalogItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _UnreadNotiEvent_notification(ctx context.Context, field graphql.CollectedField, obj *entity.UnreadNotiEvent) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4134,7 +10075,7 @@ func (ec *executionContext) _ThreadCatalogItem_createdAt(ctx context.Context, fi
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ThreadCatalogItem",
+		Object:   "UnreadNotiEvent",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4143,7 +10084,7 @@ func (ec *executionContext) _ThreadCatalogItem_createdAt(ctx context.Context, fi
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.Notification, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4155,12 +10096,12 @@ func (ec *executionContext) _ThreadCatalogItem_createdAt(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*entity.Notification)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNNotification2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐNotification(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ThreadOutline_id(ctx context.Context, field graphql.CollectedField, obj *entity.ThreadOutline) (ret graphql.Marshaler) {
+func (ec *executionContext) _User_email(ctx context.Context, field graphql.CollectedField, obj *entity.User) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4168,7 +10109,7 @@ func (ec *executionContext) _ThreadOutline_id(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ThreadOutline",
+		Object:   "User",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4177,24 +10118,21 @@ func (ec *executionContext) _ThreadOutline_id(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Email, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(uid.UID)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ThreadOutline_title(ctx context.Context, field graphql.CollectedField, obj *entity.ThreadOutline) (ret graphql.Marshaler) {
+func (ec *executionContext) _User_name(ctx context.Context, field graphql.CollectedField, obj *entity.User) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4202,7 +10140,7 @@ func (ec *executionContext) _ThreadOutline_title(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ThreadOutline",
+		Object:   "User",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4211,7 +10149,7 @@ func (ec *executionContext) _ThreadOutline_title(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Title, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4225,7 +10163,7 @@ func (ec *executionContext) _ThreadOutline_title(ctx context.Context, field grap
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ThreadOutline_content(ctx context.Context, field graphql.CollectedField, obj *entity.ThreadOutline) (ret graphql.Marshaler) {
+func (ec *executionContext) _User_tags(ctx context.Context, field graphql.CollectedField, obj *entity.User) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4233,7 +10171,7 @@ func (ec *executionContext) _ThreadOutline_content(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ThreadOutline",
+		Object:   "User",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4242,24 +10180,21 @@ func (ec *executionContext) _ThreadOutline_content(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Content, nil
+		return obj.Tags, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ThreadOutline_mainTag(ctx context.Context, field graphql.CollectedField, obj *entity.ThreadOutline) (ret graphql.Marshaler) {
+func (ec *executionContext) _User_role(ctx context.Context, field graphql.CollectedField, obj *entity.User) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4267,7 +10202,7 @@ func (ec *executionContext) _ThreadOutline_mainTag(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ThreadOutline",
+		Object:   "User",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4276,7 +10211,7 @@ func (ec *executionContext) _ThreadOutline_mainTag(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MainTag, nil
+		return obj.Role, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4288,12 +10223,12 @@ func (ec *executionContext) _ThreadOutline_mainTag(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(entity.Role)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNRole2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐRole(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ThreadOutline_subTags(ctx context.Context, field graphql.CollectedField, obj *entity.ThreadOutline) (ret graphql.Marshaler) {
+func (ec *executionContext) _User_autoWatch(ctx context.Context, field graphql.CollectedField, obj *entity.User) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4301,7 +10236,7 @@ func (ec *executionContext) _ThreadOutline_subTags(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ThreadOutline",
+		Object:   "User",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4310,7 +10245,7 @@ func (ec *executionContext) _ThreadOutline_subTags(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SubTags, nil
+		return obj.AutoWatch, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4322,12 +10257,12 @@ func (ec *executionContext) _ThreadOutline_subTags(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ThreadSlice_threads(ctx context.Context, field graphql.CollectedField, obj *entity.ThreadSlice) (ret graphql.Marshaler) {
+func (ec *executionContext) _User_banReason(ctx context.Context, field graphql.CollectedField, obj *entity.User) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4335,7 +10270,7 @@ func (ec *executionContext) _ThreadSlice_threads(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ThreadSlice",
+		Object:   "User",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4344,24 +10279,21 @@ func (ec *executionContext) _ThreadSlice_threads(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Threads, nil
+		return obj.BanReason, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*entity.Thread)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNThread2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThreadᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ThreadSlice_sliceInfo(ctx context.Context, field graphql.CollectedField, obj *entity.ThreadSlice) (ret graphql.Marshaler) {
+func (ec *executionContext) _User_banExpiresAt(ctx context.Context, field graphql.CollectedField, obj *entity.User) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4369,7 +10301,7 @@ func (ec *executionContext) _ThreadSlice_sliceInfo(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ThreadSlice",
+		Object:   "User",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4378,24 +10310,21 @@ func (ec *executionContext) _ThreadSlice_sliceInfo(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SliceInfo, nil
+		return obj.BanExpiresAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.SliceInfo)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNSliceInfo2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceInfo(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_email(ctx context.Context, field graphql.CollectedField, obj *entity.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _User_tagRoles(ctx context.Context, field graphql.CollectedField, obj *entity.User) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4412,21 +10341,24 @@ func (ec *executionContext) _User_email(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Email, nil
+		return obj.TagRoles, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]entity.TagRole)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNTagRole2ᚕgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐTagRoleᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_name(ctx context.Context, field graphql.CollectedField, obj *entity.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _User_mutedTags(ctx context.Context, field graphql.CollectedField, obj *entity.User) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4443,7 +10375,7 @@ func (ec *executionContext) _User_name(ctx context.Context, field graphql.Collec
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.MutedTags, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4452,12 +10384,12 @@ func (ec *executionContext) _User_name(ctx context.Context, field graphql.Collec
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_tags(ctx context.Context, field graphql.CollectedField, obj *entity.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _User_threads(ctx context.Context, field graphql.CollectedField, obj *entity.User) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4468,27 +10400,37 @@ func (ec *executionContext) _User_tags(ctx context.Context, field graphql.Collec
 		Object:   "User",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_User_threads_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Tags, nil
+		return ec.resolvers.User().Threads(rctx, obj, args["query"].(entity.SliceQuery))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*entity.ThreadSlice)
 	fc.Result = res
-	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNThreadSlice2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThreadSlice(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_role(ctx context.Context, field graphql.CollectedField, obj *entity.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _User_posts(ctx context.Context, field graphql.CollectedField, obj *entity.User) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4499,13 +10441,20 @@ func (ec *executionContext) _User_role(ctx context.Context, field graphql.Collec
 		Object:   "User",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_User_posts_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Role, nil
+		return ec.resolvers.User().Posts(rctx, obj, args["query"].(entity.SliceQuery))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4517,12 +10466,12 @@ func (ec *executionContext) _User_role(ctx context.Context, field graphql.Collec
 		}
 		return graphql.Null
 	}
-	res := resTmp.(entity.Role)
+	res := resTmp.(*entity.PostSlice)
 	fc.Result = res
-	return ec.marshalNRole2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐRole(ctx, field.Selections, res)
+	return ec.marshalNPostSlice2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostSlice(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_threads(ctx context.Context, field graphql.CollectedField, obj *entity.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _User_bookmarks(ctx context.Context, field graphql.CollectedField, obj *entity.User) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4538,7 +10487,7 @@ func (ec *executionContext) _User_threads(ctx context.Context, field graphql.Col
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_User_threads_args(ctx, rawArgs)
+	args, err := ec.field_User_bookmarks_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -4546,7 +10495,7 @@ func (ec *executionContext) _User_threads(ctx context.Context, field graphql.Col
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().Threads(rctx, obj, args["query"].(entity.SliceQuery))
+		return ec.resolvers.User().Bookmarks(rctx, obj, args["query"].(entity.SliceQuery))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4558,12 +10507,12 @@ func (ec *executionContext) _User_threads(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.ThreadSlice)
+	res := resTmp.(*entity.BookmarkSlice)
 	fc.Result = res
-	return ec.marshalNThreadSlice2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThreadSlice(ctx, field.Selections, res)
+	return ec.marshalNBookmarkSlice2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐBookmarkSlice(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_posts(ctx context.Context, field graphql.CollectedField, obj *entity.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _User_hiddenThreads(ctx context.Context, field graphql.CollectedField, obj *entity.User) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4579,7 +10528,7 @@ func (ec *executionContext) _User_posts(ctx context.Context, field graphql.Colle
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_User_posts_args(ctx, rawArgs)
+	args, err := ec.field_User_hiddenThreads_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -4587,7 +10536,7 @@ func (ec *executionContext) _User_posts(ctx context.Context, field graphql.Colle
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().Posts(rctx, obj, args["query"].(entity.SliceQuery))
+		return ec.resolvers.User().HiddenThreads(rctx, obj, args["query"].(entity.SliceQuery))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4599,9 +10548,9 @@ func (ec *executionContext) _User_posts(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*entity.PostSlice)
+	res := resTmp.(*entity.ThreadSlice)
 	fc.Result = res
-	return ec.marshalNPostSlice2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostSlice(ctx, field.Selections, res)
+	return ec.marshalNThreadSlice2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThreadSlice(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
@@ -5695,6 +11644,42 @@ func (ec *executionContext) unmarshalInputPostInput(ctx context.Context, obj int
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputReportAction(ctx context.Context, obj interface{}) (entity.ReportAction, error) {
+	var it entity.ReportAction
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "block":
+			var err error
+			it.Block, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "lock":
+			var err error
+			it.Lock, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "banUser":
+			var err error
+			it.BanUser, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "banDuration":
+			var err error
+			it.BanDuration, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputSliceQuery(ctx context.Context, obj interface{}) (entity.SliceQuery, error) {
 	var it entity.SliceQuery
 	var asMap = obj.(map[string]interface{})
@@ -5771,6 +11756,29 @@ func (ec *executionContext) unmarshalInputThreadInput(ctx context.Context, obj i
 
 // region    ************************** interface.gotpl ***************************
 
+func (ec *executionContext) _BookmarkItem(ctx context.Context, sel ast.SelectionSet, obj entity.BookmarkItem) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case entity.Thread:
+		return ec._Thread(ctx, sel, &obj)
+	case *entity.Thread:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Thread(ctx, sel, obj)
+	case entity.Post:
+		return ec._Post(ctx, sel, &obj)
+	case *entity.Post:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Post(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
 func (ec *executionContext) _NotiContent(ctx context.Context, sel ast.SelectionSet, obj entity.NotiContent) graphql.Marshaler {
 	switch obj := (obj).(type) {
 	case nil:
@@ -5801,6 +11809,29 @@ func (ec *executionContext) _NotiContent(ctx context.Context, sel ast.SelectionS
 	}
 }
 
+func (ec *executionContext) _SearchItem(ctx context.Context, sel ast.SelectionSet, obj entity.SearchItem) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case entity.Thread:
+		return ec._Thread(ctx, sel, &obj)
+	case *entity.Thread:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Thread(ctx, sel, obj)
+	case entity.Post:
+		return ec._Post(ctx, sel, &obj)
+	case *entity.Post:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Post(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
 // endregion ************************** interface.gotpl ***************************
 
 // region    **************************** object.gotpl ****************************
@@ -5826,6 +11857,140 @@ func (ec *executionContext) _Author(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
+		case "isOP":
+			out.Values[i] = ec._Author_isOP(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var bookmarkSliceImplementors = []string{"BookmarkSlice"}
+
+func (ec *executionContext) _BookmarkSlice(ctx context.Context, sel ast.SelectionSet, obj *entity.BookmarkSlice) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, bookmarkSliceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BookmarkSlice")
+		case "items":
+			out.Values[i] = ec._BookmarkSlice_items(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "sliceInfo":
+			out.Values[i] = ec._BookmarkSlice_sliceInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var modLogImplementors = []string{"ModLog"}
+
+func (ec *executionContext) _ModLog(ctx context.Context, sel ast.SelectionSet, obj *entity.ModLog) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, modLogImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ModLog")
+		case "id":
+			out.Values[i] = ec._ModLog_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "createdAt":
+			out.Values[i] = ec._ModLog_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "actor":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ModLog_actor(ctx, field, obj)
+				return res
+			})
+		case "action":
+			out.Values[i] = ec._ModLog_action(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "targetId":
+			out.Values[i] = ec._ModLog_targetId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "reason":
+			out.Values[i] = ec._ModLog_reason(ctx, field, obj)
+		case "before":
+			out.Values[i] = ec._ModLog_before(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "after":
+			out.Values[i] = ec._ModLog_after(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var modLogSliceImplementors = []string{"ModLogSlice"}
+
+func (ec *executionContext) _ModLogSlice(ctx context.Context, sel ast.SelectionSet, obj *entity.ModLogSlice) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, modLogSliceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ModLogSlice")
+		case "logs":
+			out.Values[i] = ec._ModLogSlice_logs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "sliceInfo":
+			out.Values[i] = ec._ModLogSlice_sliceInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -5852,28 +12017,153 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 		switch field.Name {
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("Mutation")
+		case "bookmark":
+			out.Values[i] = ec._Mutation_bookmark(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "unbookmark":
+			out.Values[i] = ec._Mutation_unbookmark(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "banNetwork":
+			out.Values[i] = ec._Mutation_banNetwork(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "unbanNetwork":
+			out.Values[i] = ec._Mutation_unbanNetwork(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		case "pubPost":
 			out.Values[i] = ec._Mutation_pubPost(ctx, field)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "blockPost":
-			out.Values[i] = ec._Mutation_blockPost(ctx, field)
+		case "editPost":
+			out.Values[i] = ec._Mutation_editPost(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "blockPost":
+			out.Values[i] = ec._Mutation_blockPost(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "unblockPost":
+			out.Values[i] = ec._Mutation_unblockPost(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "reportThread":
+			out.Values[i] = ec._Mutation_reportThread(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "reportPost":
+			out.Values[i] = ec._Mutation_reportPost(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "resolveReport":
+			out.Values[i] = ec._Mutation_resolveReport(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "dismissReport":
+			out.Values[i] = ec._Mutation_dismissReport(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "addMainTag":
+			out.Values[i] = ec._Mutation_addMainTag(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "renameMainTag":
+			out.Values[i] = ec._Mutation_renameMainTag(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "retireMainTag":
+			out.Values[i] = ec._Mutation_retireMainTag(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "reorderMainTags":
+			out.Values[i] = ec._Mutation_reorderMainTags(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "mergeTags":
+			out.Values[i] = ec._Mutation_mergeTags(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "editTag":
+			out.Values[i] = ec._Mutation_editTag(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "pubThread":
+			out.Values[i] = ec._Mutation_pubThread(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "editThread":
+			out.Values[i] = ec._Mutation_editThread(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "watchThread":
+			out.Values[i] = ec._Mutation_watchThread(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "unwatchThread":
+			out.Values[i] = ec._Mutation_unwatchThread(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "hideThread":
+			out.Values[i] = ec._Mutation_hideThread(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "unhideThread":
+			out.Values[i] = ec._Mutation_unhideThread(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "lockThread":
+			out.Values[i] = ec._Mutation_lockThread(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "unlockThread":
+			out.Values[i] = ec._Mutation_unlockThread(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "blockThread":
+			out.Values[i] = ec._Mutation_blockThread(ctx, field)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "pubThread":
-			out.Values[i] = ec._Mutation_pubThread(ctx, field)
+		case "unblockThread":
+			out.Values[i] = ec._Mutation_unblockThread(ctx, field)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "lockThread":
-			out.Values[i] = ec._Mutation_lockThread(ctx, field)
+		case "pinThread":
+			out.Values[i] = ec._Mutation_pinThread(ctx, field)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "blockThread":
-			out.Values[i] = ec._Mutation_blockThread(ctx, field)
+		case "unpinThread":
+			out.Values[i] = ec._Mutation_unpinThread(ctx, field)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -5907,11 +12197,109 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
+		case "muteTag":
+			out.Values[i] = ec._Mutation_muteTag(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "unmuteTag":
+			out.Values[i] = ec._Mutation_unmuteTag(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "setAutoWatch":
+			out.Values[i] = ec._Mutation_setAutoWatch(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		case "banUser":
 			out.Values[i] = ec._Mutation_banUser(ctx, field)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
+		case "unbanUser":
+			out.Values[i] = ec._Mutation_unbanUser(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "setUserRole":
+			out.Values[i] = ec._Mutation_setUserRole(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var netBanImplementors = []string{"NetBan"}
+
+func (ec *executionContext) _NetBan(ctx context.Context, sel ast.SelectionSet, obj *entity.NetBan) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, netBanImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("NetBan")
+		case "id":
+			out.Values[i] = ec._NetBan_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._NetBan_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "targetId":
+			out.Values[i] = ec._NetBan_targetId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "reason":
+			out.Values[i] = ec._NetBan_reason(ctx, field, obj)
+		case "expiresAt":
+			out.Values[i] = ec._NetBan_expiresAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var netBanSliceImplementors = []string{"NetBanSlice"}
+
+func (ec *executionContext) _NetBanSlice(ctx context.Context, sel ast.SelectionSet, obj *entity.NetBanSlice) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, netBanSliceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("NetBanSlice")
+		case "bans":
+			out.Values[i] = ec._NetBanSlice_bans(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "sliceInfo":
+			out.Values[i] = ec._NetBanSlice_sliceInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -5997,7 +12385,7 @@ func (ec *executionContext) _Notification(ctx context.Context, sel ast.Selection
 	return out
 }
 
-var postImplementors = []string{"Post"}
+var postImplementors = []string{"Post", "BookmarkItem", "SearchItem"}
 
 func (ec *executionContext) _Post(ctx context.Context, sel ast.SelectionSet, obj *entity.Post) graphql.Marshaler {
 	fields := graphql.CollectFields(ec.OperationContext, sel, postImplementors)
@@ -6058,6 +12446,33 @@ func (ec *executionContext) _Post(ctx context.Context, sel ast.SelectionSet, obj
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
+		case "bookmarked":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Post_bookmarked(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "editedAt":
+			out.Values[i] = ec._Post_editedAt(ctx, field, obj)
+		case "revisions":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Post_revisions(ctx, field, obj)
+				return res
+			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -6153,6 +12568,34 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 		switch field.Name {
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("Query")
+		case "modLog":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_modLog(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "netBans":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_netBans(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
 		case "unreadNotiCount":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
@@ -6195,6 +12638,34 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				}
 				return res
 			})
+		case "reports":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_reports(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "search":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_search(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
 		case "mainTags":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
@@ -6209,6 +12680,20 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				}
 				return res
 			})
+		case "tag":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_tag(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
 		case "recommended":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
@@ -6237,6 +12722,20 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				}
 				return res
 			})
+		case "trendingTags":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_trendingTags(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
 		case "threadSlice":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
@@ -6251,6 +12750,172 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				}
 				return res
 			})
+		case "timeline":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_timeline(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "thread":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_thread(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "profile":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_profile(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "__type":
+			out.Values[i] = ec._Query___type(ctx, field)
+		case "__schema":
+			out.Values[i] = ec._Query___schema(ctx, field)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var quotedNotiImplementors = []string{"QuotedNoti", "NotiContent"}
+
+func (ec *executionContext) _QuotedNoti(ctx context.Context, sel ast.SelectionSet, obj *entity.QuotedNoti) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, quotedNotiImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("QuotedNoti")
+		case "threadId":
+			out.Values[i] = ec._QuotedNoti_threadId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "quotedPost":
+			out.Values[i] = ec._QuotedNoti_quotedPost(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "post":
+			out.Values[i] = ec._QuotedNoti_post(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var repliedNotiImplementors = []string{"RepliedNoti", "NotiContent"}
+
+func (ec *executionContext) _RepliedNoti(ctx context.Context, sel ast.SelectionSet, obj *entity.RepliedNoti) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, repliedNotiImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RepliedNoti")
+		case "thread":
+			out.Values[i] = ec._RepliedNoti_thread(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "newRepliesCount":
+			out.Values[i] = ec._RepliedNoti_newRepliesCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "firstReplyId":
+			out.Values[i] = ec._RepliedNoti_firstReplyId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var reportImplementors = []string{"Report"}
+
+func (ec *executionContext) _Report(ctx context.Context, sel ast.SelectionSet, obj *entity.Report) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, reportImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Report")
+		case "id":
+			out.Values[i] = ec._Report_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "createdAt":
+			out.Values[i] = ec._Report_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "reason":
+			out.Values[i] = ec._Report_reason(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "text":
+			out.Values[i] = ec._Report_text(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._Report_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "handledAt":
+			out.Values[i] = ec._Report_handledAt(ctx, field, obj)
 		case "thread":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
@@ -6259,13 +12924,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_thread(ctx, field)
+				res = ec._Report_thread(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "profile":
+		case "post":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -6273,16 +12938,9 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_profile(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
+				res = ec._Report_post(ctx, field, obj)
 				return res
 			})
-		case "__type":
-			out.Values[i] = ec._Query___type(ctx, field)
-		case "__schema":
-			out.Values[i] = ec._Query___schema(ctx, field)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -6294,29 +12952,24 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 	return out
 }
 
-var quotedNotiImplementors = []string{"QuotedNoti", "NotiContent"}
+var reportSliceImplementors = []string{"ReportSlice"}
 
-func (ec *executionContext) _QuotedNoti(ctx context.Context, sel ast.SelectionSet, obj *entity.QuotedNoti) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, quotedNotiImplementors)
+func (ec *executionContext) _ReportSlice(ctx context.Context, sel ast.SelectionSet, obj *entity.ReportSlice) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, reportSliceImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("QuotedNoti")
-		case "threadId":
-			out.Values[i] = ec._QuotedNoti_threadId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "quotedPost":
-			out.Values[i] = ec._QuotedNoti_quotedPost(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ReportSlice")
+		case "reports":
+			out.Values[i] = ec._ReportSlice_reports(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "post":
-			out.Values[i] = ec._QuotedNoti_post(ctx, field, obj)
+		case "sliceInfo":
+			out.Values[i] = ec._ReportSlice_sliceInfo(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -6331,29 +12984,58 @@ func (ec *executionContext) _QuotedNoti(ctx context.Context, sel ast.SelectionSe
 	return out
 }
 
-var repliedNotiImplementors = []string{"RepliedNoti", "NotiContent"}
+var revisionImplementors = []string{"Revision"}
 
-func (ec *executionContext) _RepliedNoti(ctx context.Context, sel ast.SelectionSet, obj *entity.RepliedNoti) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, repliedNotiImplementors)
+func (ec *executionContext) _Revision(ctx context.Context, sel ast.SelectionSet, obj *entity.Revision) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, revisionImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RepliedNoti")
-		case "thread":
-			out.Values[i] = ec._RepliedNoti_thread(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("Revision")
+		case "createdAt":
+			out.Values[i] = ec._Revision_createdAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "newRepliesCount":
-			out.Values[i] = ec._RepliedNoti_newRepliesCount(ctx, field, obj)
+		case "title":
+			out.Values[i] = ec._Revision_title(ctx, field, obj)
+		case "content":
+			out.Values[i] = ec._Revision_content(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "firstReplyId":
-			out.Values[i] = ec._RepliedNoti_firstReplyId(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var searchSliceImplementors = []string{"SearchSlice"}
+
+func (ec *executionContext) _SearchSlice(ctx context.Context, sel ast.SelectionSet, obj *entity.SearchSlice) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, searchSliceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SearchSlice")
+		case "items":
+			out.Values[i] = ec._SearchSlice_items(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "sliceInfo":
+			out.Values[i] = ec._SearchSlice_sliceInfo(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -6405,6 +13087,28 @@ func (ec *executionContext) _SliceInfo(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
+var subscriptionImplementors = []string{"Subscription"}
+
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func() graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		ec.Errorf(ctx, "must subscribe to exactly one stream")
+		return nil
+	}
+
+	switch fields[0].Name {
+	case "unreadNoti":
+		return ec._Subscription_unreadNoti(ctx, fields[0])
+	case "threadReplies":
+		return ec._Subscription_threadReplies(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
+
 var systemNotiImplementors = []string{"SystemNoti", "NotiContent"}
 
 func (ec *executionContext) _SystemNoti(ctx context.Context, sel ast.SelectionSet, obj *entity.SystemNoti) graphql.Marshaler {
@@ -6458,6 +13162,54 @@ func (ec *executionContext) _Tag(ctx context.Context, sel ast.SelectionSet, obj
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
+		case "description":
+			out.Values[i] = ec._Tag_description(ctx, field, obj)
+		case "rules":
+			out.Values[i] = ec._Tag_rules(ctx, field, obj)
+		case "threadCount":
+			out.Values[i] = ec._Tag_threadCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "subscriberCount":
+			out.Values[i] = ec._Tag_subscriberCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "activeAt":
+			out.Values[i] = ec._Tag_activeAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var tagRoleImplementors = []string{"TagRole"}
+
+func (ec *executionContext) _TagRole(ctx context.Context, sel ast.SelectionSet, obj *entity.TagRole) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, tagRoleImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TagRole")
+		case "tag":
+			out.Values[i] = ec._TagRole_tag(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "role":
+			out.Values[i] = ec._TagRole_role(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -6469,7 +13221,7 @@ func (ec *executionContext) _Tag(ctx context.Context, sel ast.SelectionSet, obj
 	return out
 }
 
-var threadImplementors = []string{"Thread"}
+var threadImplementors = []string{"Thread", "BookmarkItem", "SearchItem"}
 
 func (ec *executionContext) _Thread(ctx context.Context, sel ast.SelectionSet, obj *entity.Thread) graphql.Marshaler {
 	fields := graphql.CollectFields(ec.OperationContext, sel, threadImplementors)
@@ -6551,6 +13303,34 @@ func (ec *executionContext) _Thread(ctx context.Context, sel ast.SelectionSet, o
 				res = ec._Thread_catalog(ctx, field, obj)
 				return res
 			})
+		case "watched":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Thread_watched(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "bookmarked":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Thread_bookmarked(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
 		case "blocked":
 			out.Values[i] = ec._Thread_blocked(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -6561,6 +13341,26 @@ func (ec *executionContext) _Thread(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
+		case "pinned":
+			out.Values[i] = ec._Thread_pinned(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "pinnedUntil":
+			out.Values[i] = ec._Thread_pinnedUntil(ctx, field, obj)
+		case "editedAt":
+			out.Values[i] = ec._Thread_editedAt(ctx, field, obj)
+		case "revisions":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Thread_revisions(ctx, field, obj)
+				return res
+			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -6680,6 +13480,38 @@ func (ec *executionContext) _ThreadSlice(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
+var unreadNotiEventImplementors = []string{"UnreadNotiEvent"}
+
+func (ec *executionContext) _UnreadNotiEvent(ctx context.Context, sel ast.SelectionSet, obj *entity.UnreadNotiEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, unreadNotiEventImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("UnreadNotiEvent")
+		case "unreadCount":
+			out.Values[i] = ec._UnreadNotiEvent_unreadCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "notification":
+			out.Values[i] = ec._UnreadNotiEvent_notification(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var userImplementors = []string{"User"}
 
 func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj *entity.User) graphql.Marshaler {
@@ -6702,6 +13534,22 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
+		case "autoWatch":
+			out.Values[i] = ec._User_autoWatch(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "banReason":
+			out.Values[i] = ec._User_banReason(ctx, field, obj)
+		case "banExpiresAt":
+			out.Values[i] = ec._User_banExpiresAt(ctx, field, obj)
+		case "tagRoles":
+			out.Values[i] = ec._User_tagRoles(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "mutedTags":
+			out.Values[i] = ec._User_mutedTags(ctx, field, obj)
 		case "threads":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
@@ -6710,13 +13558,41 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._User_threads(ctx, field, obj)
+				res = ec._User_threads(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "posts":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._User_posts(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "bookmarks":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._User_bookmarks(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "posts":
+		case "hiddenThreads":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -6724,7 +13600,7 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._User_posts(ctx, field, obj)
+				res = ec._User_hiddenThreads(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
@@ -7000,6 +13876,67 @@ func (ec *executionContext) marshalNAuthor2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexk
 	return ec._Author(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNBookmarkItem2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐBookmarkItem(ctx context.Context, sel ast.SelectionSet, v entity.BookmarkItem) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._BookmarkItem(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNBookmarkItem2ᚕgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐBookmarkItemᚄ(ctx context.Context, sel ast.SelectionSet, v []entity.BookmarkItem) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNBookmarkItem2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐBookmarkItem(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNBookmarkSlice2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐBookmarkSlice(ctx context.Context, sel ast.SelectionSet, v entity.BookmarkSlice) graphql.Marshaler {
+	return ec._BookmarkSlice(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNBookmarkSlice2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐBookmarkSlice(ctx context.Context, sel ast.SelectionSet, v *entity.BookmarkSlice) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._BookmarkSlice(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
 	return graphql.UnmarshalBoolean(v)
 }
@@ -7028,6 +13965,145 @@ func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.Selecti
 	return res
 }
 
+func (ec *executionContext) unmarshalNModAction2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐModAction(ctx context.Context, v interface{}) (entity.ModAction, error) {
+	var res entity.ModAction
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalNModAction2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐModAction(ctx context.Context, sel ast.SelectionSet, v entity.ModAction) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNModLog2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐModLog(ctx context.Context, sel ast.SelectionSet, v entity.ModLog) graphql.Marshaler {
+	return ec._ModLog(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNModLog2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐModLogᚄ(ctx context.Context, sel ast.SelectionSet, v []*entity.ModLog) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNModLog2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐModLog(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNModLog2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐModLog(ctx context.Context, sel ast.SelectionSet, v *entity.ModLog) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._ModLog(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNModLogSlice2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐModLogSlice(ctx context.Context, sel ast.SelectionSet, v entity.ModLogSlice) graphql.Marshaler {
+	return ec._ModLogSlice(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNModLogSlice2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐModLogSlice(ctx context.Context, sel ast.SelectionSet, v *entity.ModLogSlice) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._ModLogSlice(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNNetBan2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐNetBan(ctx context.Context, sel ast.SelectionSet, v entity.NetBan) graphql.Marshaler {
+	return ec._NetBan(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNNetBan2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐNetBanᚄ(ctx context.Context, sel ast.SelectionSet, v []*entity.NetBan) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNNetBan2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐNetBan(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNNetBan2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐNetBan(ctx context.Context, sel ast.SelectionSet, v *entity.NetBan) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._NetBan(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNNetBanSlice2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐNetBanSlice(ctx context.Context, sel ast.SelectionSet, v entity.NetBanSlice) graphql.Marshaler {
+	return ec._NetBanSlice(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNNetBanSlice2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐNetBanSlice(ctx context.Context, sel ast.SelectionSet, v *entity.NetBanSlice) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._NetBanSlice(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNNotiContent2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐNotiContent(ctx context.Context, sel ast.SelectionSet, v entity.NotiContent) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -7102,21 +14178,104 @@ func (ec *executionContext) marshalNNotification2ᚕᚖgitlabᚗcomᚋabyssᚗcl
 	return ret
 }
 
-func (ec *executionContext) marshalNNotification2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐNotification(ctx context.Context, sel ast.SelectionSet, v *entity.Notification) graphql.Marshaler {
+func (ec *executionContext) marshalNNotification2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐNotification(ctx context.Context, sel ast.SelectionSet, v *entity.Notification) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._Notification(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNPost2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPost(ctx context.Context, sel ast.SelectionSet, v entity.Post) graphql.Marshaler {
+	return ec._Post(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPost2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostᚄ(ctx context.Context, sel ast.SelectionSet, v []*entity.Post) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPost2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPost(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNPost2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPost(ctx context.Context, sel ast.SelectionSet, v *entity.Post) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._Post(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNPostInput2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostInput(ctx context.Context, v interface{}) (entity.PostInput, error) {
+	return ec.unmarshalInputPostInput(ctx, v)
+}
+
+func (ec *executionContext) marshalNPostOutline2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostOutline(ctx context.Context, sel ast.SelectionSet, v entity.PostOutline) graphql.Marshaler {
+	return ec._PostOutline(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPostOutline2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostOutline(ctx context.Context, sel ast.SelectionSet, v *entity.PostOutline) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._PostOutline(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNPostSlice2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostSlice(ctx context.Context, sel ast.SelectionSet, v entity.PostSlice) graphql.Marshaler {
+	return ec._PostSlice(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPostSlice2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostSlice(ctx context.Context, sel ast.SelectionSet, v *entity.PostSlice) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._Notification(ctx, sel, v)
+	return ec._PostSlice(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNPost2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPost(ctx context.Context, sel ast.SelectionSet, v entity.Post) graphql.Marshaler {
-	return ec._Post(ctx, sel, &v)
+func (ec *executionContext) marshalNReport2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReport(ctx context.Context, sel ast.SelectionSet, v entity.Report) graphql.Marshaler {
+	return ec._Report(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNPost2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostᚄ(ctx context.Context, sel ast.SelectionSet, v []*entity.Post) graphql.Marshaler {
+func (ec *executionContext) marshalNReport2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReportᚄ(ctx context.Context, sel ast.SelectionSet, v []*entity.Report) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7140,7 +14299,7 @@ func (ec *executionContext) marshalNPost2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuex
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNPost2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPost(ctx, sel, v[i])
+			ret[i] = ec.marshalNReport2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReport(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7153,46 +14312,60 @@ func (ec *executionContext) marshalNPost2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuex
 	return ret
 }
 
-func (ec *executionContext) marshalNPost2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPost(ctx context.Context, sel ast.SelectionSet, v *entity.Post) graphql.Marshaler {
+func (ec *executionContext) marshalNReport2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReport(ctx context.Context, sel ast.SelectionSet, v *entity.Report) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._Post(ctx, sel, v)
+	return ec._Report(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNPostInput2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostInput(ctx context.Context, v interface{}) (entity.PostInput, error) {
-	return ec.unmarshalInputPostInput(ctx, v)
+func (ec *executionContext) unmarshalNReportReason2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReportReason(ctx context.Context, v interface{}) (entity.ReportReason, error) {
+	var res entity.ReportReason
+	return res, res.UnmarshalGQL(v)
 }
 
-func (ec *executionContext) marshalNPostOutline2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostOutline(ctx context.Context, sel ast.SelectionSet, v entity.PostOutline) graphql.Marshaler {
-	return ec._PostOutline(ctx, sel, &v)
+func (ec *executionContext) marshalNReportReason2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReportReason(ctx context.Context, sel ast.SelectionSet, v entity.ReportReason) graphql.Marshaler {
+	return v
 }
 
-func (ec *executionContext) marshalNPostOutline2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostOutline(ctx context.Context, sel ast.SelectionSet, v *entity.PostOutline) graphql.Marshaler {
+func (ec *executionContext) marshalNReportSlice2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReportSlice(ctx context.Context, sel ast.SelectionSet, v entity.ReportSlice) graphql.Marshaler {
+	return ec._ReportSlice(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNReportSlice2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReportSlice(ctx context.Context, sel ast.SelectionSet, v *entity.ReportSlice) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._PostOutline(ctx, sel, v)
+	return ec._ReportSlice(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNPostSlice2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostSlice(ctx context.Context, sel ast.SelectionSet, v entity.PostSlice) graphql.Marshaler {
-	return ec._PostSlice(ctx, sel, &v)
+func (ec *executionContext) unmarshalNReportStatus2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReportStatus(ctx context.Context, v interface{}) (entity.ReportStatus, error) {
+	var res entity.ReportStatus
+	return res, res.UnmarshalGQL(v)
 }
 
-func (ec *executionContext) marshalNPostSlice2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostSlice(ctx context.Context, sel ast.SelectionSet, v *entity.PostSlice) graphql.Marshaler {
+func (ec *executionContext) marshalNReportStatus2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReportStatus(ctx context.Context, sel ast.SelectionSet, v entity.ReportStatus) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNRevision2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐRevision(ctx context.Context, sel ast.SelectionSet, v entity.Revision) graphql.Marshaler {
+	return ec._Revision(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNRevision2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐRevision(ctx context.Context, sel ast.SelectionSet, v *entity.Revision) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._PostSlice(ctx, sel, v)
+	return ec._Revision(ctx, sel, v)
 }
 
 func (ec *executionContext) unmarshalNRole2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐRole(ctx context.Context, v interface{}) (entity.Role, error) {
@@ -7204,6 +14377,67 @@ func (ec *executionContext) marshalNRole2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋu
 	return v
 }
 
+func (ec *executionContext) marshalNSearchItem2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSearchItem(ctx context.Context, sel ast.SelectionSet, v entity.SearchItem) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._SearchItem(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNSearchItem2ᚕgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSearchItemᚄ(ctx context.Context, sel ast.SelectionSet, v []entity.SearchItem) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSearchItem2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSearchItem(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNSearchSlice2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSearchSlice(ctx context.Context, sel ast.SelectionSet, v entity.SearchSlice) graphql.Marshaler {
+	return ec._SearchSlice(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNSearchSlice2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSearchSlice(ctx context.Context, sel ast.SelectionSet, v *entity.SearchSlice) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._SearchSlice(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNSliceInfo2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐSliceInfo(ctx context.Context, sel ast.SelectionSet, v entity.SliceInfo) graphql.Marshaler {
 	return ec._SliceInfo(ctx, sel, &v)
 }
@@ -7316,6 +14550,47 @@ func (ec *executionContext) marshalNTag2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexky
 	return ec._Tag(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNTagRole2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐTagRole(ctx context.Context, sel ast.SelectionSet, v entity.TagRole) graphql.Marshaler {
+	return ec._TagRole(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNTagRole2ᚕgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐTagRoleᚄ(ctx context.Context, sel ast.SelectionSet, v []entity.TagRole) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNTagRole2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐTagRole(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
 func (ec *executionContext) marshalNThread2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThread(ctx context.Context, sel ast.SelectionSet, v entity.Thread) graphql.Marshaler {
 	return ec._Thread(ctx, sel, &v)
 }
@@ -7427,6 +14702,15 @@ func (ec *executionContext) marshalNTime2timeᚐTime(ctx context.Context, sel as
 	return res
 }
 
+func (ec *executionContext) unmarshalNTrendingWindow2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐTrendingWindow(ctx context.Context, v interface{}) (entity.TrendingWindow, error) {
+	var res entity.TrendingWindow
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalNTrendingWindow2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐTrendingWindow(ctx context.Context, sel ast.SelectionSet, v entity.TrendingWindow) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) unmarshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx context.Context, v interface{}) (uid.UID, error) {
 	var res uid.UID
 	return res, res.UnmarshalGQL(v)
@@ -7436,6 +14720,20 @@ func (ec *executionContext) marshalNUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋli
 	return v
 }
 
+func (ec *executionContext) marshalNUnreadNotiEvent2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐUnreadNotiEvent(ctx context.Context, sel ast.SelectionSet, v entity.UnreadNotiEvent) graphql.Marshaler {
+	return ec._UnreadNotiEvent(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNUnreadNotiEvent2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐUnreadNotiEvent(ctx context.Context, sel ast.SelectionSet, v *entity.UnreadNotiEvent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._UnreadNotiEvent(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNUser2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐUser(ctx context.Context, sel ast.SelectionSet, v entity.User) graphql.Marshaler {
 	return ec._User(ctx, sel, &v)
 }
@@ -7722,6 +15020,10 @@ func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.Sele
 	return ec.marshalOInt2int(ctx, sel, *v)
 }
 
+func (ec *executionContext) marshalOPost2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPost(ctx context.Context, sel ast.SelectionSet, v entity.Post) graphql.Marshaler {
+	return ec._Post(ctx, sel, &v)
+}
+
 func (ec *executionContext) marshalOPost2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPostᚄ(ctx context.Context, sel ast.SelectionSet, v []*entity.Post) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -7762,6 +15064,89 @@ func (ec *executionContext) marshalOPost2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuex
 	return ret
 }
 
+func (ec *executionContext) marshalOPost2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐPost(ctx context.Context, sel ast.SelectionSet, v *entity.Post) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Post(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOReportAction2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReportAction(ctx context.Context, v interface{}) (entity.ReportAction, error) {
+	return ec.unmarshalInputReportAction(ctx, v)
+}
+
+func (ec *executionContext) unmarshalOReportAction2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReportAction(ctx context.Context, v interface{}) (*entity.ReportAction, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalOReportAction2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReportAction(ctx, v)
+	return &res, err
+}
+
+func (ec *executionContext) unmarshalOReportStatus2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReportStatus(ctx context.Context, v interface{}) (entity.ReportStatus, error) {
+	var res entity.ReportStatus
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalOReportStatus2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReportStatus(ctx context.Context, sel ast.SelectionSet, v entity.ReportStatus) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalOReportStatus2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReportStatus(ctx context.Context, v interface{}) (*entity.ReportStatus, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalOReportStatus2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReportStatus(ctx, v)
+	return &res, err
+}
+
+func (ec *executionContext) marshalOReportStatus2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐReportStatus(ctx context.Context, sel ast.SelectionSet, v *entity.ReportStatus) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalORevision2ᚕᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐRevisionᚄ(ctx context.Context, sel ast.SelectionSet, v []*entity.Revision) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRevision2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐRevision(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
 func (ec *executionContext) unmarshalOString2string(ctx context.Context, v interface{}) (string, error) {
 	return graphql.UnmarshalString(v)
 }
@@ -7857,6 +15242,53 @@ func (ec *executionContext) marshalOThreadCatalogItem2ᚕᚖgitlabᚗcomᚋabyss
 	return ret
 }
 
+func (ec *executionContext) unmarshalOThreadSort2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThreadSort(ctx context.Context, v interface{}) (entity.ThreadSort, error) {
+	var res entity.ThreadSort
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalOThreadSort2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThreadSort(ctx context.Context, sel ast.SelectionSet, v entity.ThreadSort) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalOThreadSort2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThreadSort(ctx context.Context, v interface{}) (*entity.ThreadSort, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalOThreadSort2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThreadSort(ctx, v)
+	return &res, err
+}
+
+func (ec *executionContext) marshalOThreadSort2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐThreadSort(ctx context.Context, sel ast.SelectionSet, v *entity.ThreadSort) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOTime2timeᚐTime(ctx context.Context, v interface{}) (time.Time, error) {
+	return graphql.UnmarshalTime(v)
+}
+
+func (ec *executionContext) marshalOTime2timeᚐTime(ctx context.Context, sel ast.SelectionSet, v time.Time) graphql.Marshaler {
+	return graphql.MarshalTime(v)
+}
+
+func (ec *executionContext) unmarshalOTime2ᚖtimeᚐTime(ctx context.Context, v interface{}) (*time.Time, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalOTime2timeᚐTime(ctx, v)
+	return &res, err
+}
+
+func (ec *executionContext) marshalOTime2ᚖtimeᚐTime(ctx context.Context, sel ast.SelectionSet, v *time.Time) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec.marshalOTime2timeᚐTime(ctx, sel, *v)
+}
+
 func (ec *executionContext) unmarshalOUID2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋlibᚋuidᚐUID(ctx context.Context, v interface{}) (uid.UID, error) {
 	var res uid.UID
 	return res, res.UnmarshalGQL(v)
@@ -7913,6 +15345,17 @@ func (ec *executionContext) marshalOUID2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexky
 	return v
 }
 
+func (ec *executionContext) marshalOUser2gitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐUser(ctx context.Context, sel ast.SelectionSet, v entity.User) graphql.Marshaler {
+	return ec._User(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalOUser2ᚖgitlabᚗcomᚋabyssᚗclubᚋuexkyᚋuexkyᚋentityᚐUser(ctx context.Context, sel ast.SelectionSet, v *entity.User) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._User(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
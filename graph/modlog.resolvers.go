@@ -0,0 +1,28 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"gitlab.com/abyss.club/uexky/graph/generated"
+	"gitlab.com/abyss.club/uexky/uexky/entity"
+)
+
+func (r *modLogResolver) Actor(ctx context.Context, obj *entity.ModLog) (*entity.User, error) {
+	return r.Uexky.GetModLogActor(ctx, obj)
+}
+
+func (r *queryResolver) ModLog(ctx context.Context, query entity.SliceQuery) (*entity.ModLogSlice, error) {
+	return r.Uexky.GetModLogs(ctx, query)
+}
+
+// ModLog returns generated.ModLogResolver implementation.
+func (r *Resolver) ModLog() generated.ModLogResolver { return &modLogResolver{r} }
+
+// Query returns generated.QueryResolver implementation.
+func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
+
+type modLogResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
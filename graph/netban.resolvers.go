@@ -0,0 +1,23 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"gitlab.com/abyss.club/uexky/lib/uid"
+	"gitlab.com/abyss.club/uexky/uexky/entity"
+)
+
+func (r *mutationResolver) BanNetwork(ctx context.Context, postID *uid.UID, threadID *uid.UID, prefix *int, reason *string, duration *int) (*entity.NetBan, error) {
+	return r.Uexky.BanNetwork(ctx, postID, threadID, prefix, reason, duration)
+}
+
+func (r *mutationResolver) UnbanNetwork(ctx context.Context, id uid.UID, reason *string) (bool, error) {
+	return r.Uexky.UnbanNetwork(ctx, id, reason)
+}
+
+func (r *queryResolver) NetBans(ctx context.Context, query entity.SliceQuery) (*entity.NetBanSlice, error) {
+	return r.Uexky.GetNetBans(ctx, query)
+}
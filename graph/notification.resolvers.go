@@ -18,7 +18,11 @@ func (r *queryResolver) Notification(ctx context.Context, query entity.SliceQuer
 	return r.Uexky.GetNotifications(ctx, query)
 }
 
-// Query returns generated.QueryResolver implementation.
-func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
+func (r *subscriptionResolver) UnreadNoti(ctx context.Context) (<-chan *entity.UnreadNotiEvent, error) {
+	return r.Uexky.SubscribeUnreadNoti(ctx)
+}
+
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
 
-type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
@@ -15,8 +15,16 @@ func (r *mutationResolver) PubPost(ctx context.Context, post entity.PostInput) (
 	return r.Uexky.PubPost(ctx, post)
 }
 
-func (r *mutationResolver) BlockPost(ctx context.Context, postID uid.UID) (*entity.Post, error) {
-	return r.Uexky.BlockPost(ctx, postID)
+func (r *mutationResolver) EditPost(ctx context.Context, postID uid.UID, content string) (*entity.Post, error) {
+	return r.Uexky.EditPost(ctx, postID, content)
+}
+
+func (r *mutationResolver) BlockPost(ctx context.Context, postID uid.UID, reason *string) (*entity.Post, error) {
+	return r.Uexky.BlockPost(ctx, postID, reason)
+}
+
+func (r *mutationResolver) UnblockPost(ctx context.Context, postID uid.UID, reason *string) (*entity.Post, error) {
+	return r.Uexky.UnblockPost(ctx, postID, reason)
 }
 
 func (r *postResolver) Quotes(ctx context.Context, obj *entity.Post) ([]*entity.Post, error) {
@@ -27,15 +35,23 @@ func (r *postResolver) QuotedCount(ctx context.Context, obj *entity.Post) (int,
 	return r.Uexky.GetPostQuotedCount(ctx, obj)
 }
 
+func (r *postResolver) Bookmarked(ctx context.Context, obj *entity.Post) (bool, error) {
+	return r.Uexky.GetBookmarked(ctx, obj.ID)
+}
+
+func (r *postResolver) Revisions(ctx context.Context, obj *entity.Post) ([]*entity.Revision, error) {
+	return r.Uexky.GetRevisions(ctx, obj.ID)
+}
+
 func (r *queryResolver) Post(ctx context.Context, id uid.UID) (*entity.Post, error) {
 	return r.Uexky.GetPostByID(ctx, id)
 }
 
-// Mutation returns generated.MutationResolver implementation.
-func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
+func (r *subscriptionResolver) ThreadReplies(ctx context.Context, threadID uid.UID) (<-chan *entity.Post, error) {
+	return r.Uexky.SubscribeThreadReplies(ctx, threadID)
+}
 
 // Post returns generated.PostResolver implementation.
 func (r *Resolver) Post() generated.PostResolver { return &postResolver{r} }
 
-type mutationResolver struct{ *Resolver }
 type postResolver struct{ *Resolver }
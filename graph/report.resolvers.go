@@ -0,0 +1,45 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"gitlab.com/abyss.club/uexky/graph/generated"
+	"gitlab.com/abyss.club/uexky/lib/uid"
+	"gitlab.com/abyss.club/uexky/uexky/entity"
+)
+
+func (r *mutationResolver) ReportThread(ctx context.Context, threadID uid.UID, reason entity.ReportReason, text *string) (bool, error) {
+	return r.Uexky.ReportThread(ctx, threadID, reason, text)
+}
+
+func (r *mutationResolver) ReportPost(ctx context.Context, postID uid.UID, reason entity.ReportReason, text *string) (bool, error) {
+	return r.Uexky.ReportPost(ctx, postID, reason, text)
+}
+
+func (r *mutationResolver) ResolveReport(ctx context.Context, reportID uid.UID, action *entity.ReportAction, reason *string) (*entity.Report, error) {
+	return r.Uexky.ResolveReport(ctx, reportID, action, reason)
+}
+
+func (r *mutationResolver) DismissReport(ctx context.Context, reportID uid.UID) (*entity.Report, error) {
+	return r.Uexky.DismissReport(ctx, reportID)
+}
+
+func (r *queryResolver) Reports(ctx context.Context, status *entity.ReportStatus, query entity.SliceQuery) (*entity.ReportSlice, error) {
+	return r.Uexky.GetReports(ctx, status, query)
+}
+
+func (r *reportResolver) Thread(ctx context.Context, obj *entity.Report) (*entity.Thread, error) {
+	return r.Uexky.GetReportThread(ctx, obj)
+}
+
+func (r *reportResolver) Post(ctx context.Context, obj *entity.Report) (*entity.Post, error) {
+	return r.Uexky.GetReportPost(ctx, obj)
+}
+
+// Report returns generated.ReportResolver implementation.
+func (r *Resolver) Report() generated.ReportResolver { return &reportResolver{r} }
+
+type reportResolver struct{ *Resolver }
@@ -0,0 +1,14 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"gitlab.com/abyss.club/uexky/uexky/entity"
+)
+
+func (r *queryResolver) Search(ctx context.Context, text string, tags []string, query entity.SliceQuery) (*entity.SearchSlice, error) {
+	return r.Uexky.Search(ctx, text, tags, query)
+}
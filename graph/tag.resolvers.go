@@ -9,14 +9,46 @@ import (
 	"gitlab.com/abyss.club/uexky/uexky/entity"
 )
 
+func (r *mutationResolver) AddMainTag(ctx context.Context, tag string) ([]string, error) {
+	return r.Uexky.AddMainTag(ctx, tag)
+}
+
+func (r *mutationResolver) RenameMainTag(ctx context.Context, tag string, newName string) ([]string, error) {
+	return r.Uexky.RenameMainTag(ctx, tag, newName)
+}
+
+func (r *mutationResolver) RetireMainTag(ctx context.Context, tag string) ([]string, error) {
+	return r.Uexky.RetireMainTag(ctx, tag)
+}
+
+func (r *mutationResolver) ReorderMainTags(ctx context.Context, tags []string) ([]string, error) {
+	return r.Uexky.ReorderMainTags(ctx, tags)
+}
+
+func (r *mutationResolver) MergeTags(ctx context.Context, from string, into string) (bool, error) {
+	return r.Uexky.MergeTags(ctx, from, into)
+}
+
+func (r *mutationResolver) EditTag(ctx context.Context, name string, description *string, rules *string) (*entity.Tag, error) {
+	return r.Uexky.EditTag(ctx, name, description, rules)
+}
+
 func (r *queryResolver) MainTags(ctx context.Context) ([]string, error) {
 	return r.Uexky.GetMainTags(ctx), nil
 }
 
+func (r *queryResolver) Tag(ctx context.Context, name string) (*entity.Tag, error) {
+	return r.Uexky.GetTag(ctx, name)
+}
+
 func (r *queryResolver) Recommended(ctx context.Context) ([]string, error) {
-	return r.Uexky.GetRecommendedTags(ctx), nil
+	return r.Uexky.GetRecommendedTags(ctx)
 }
 
 func (r *queryResolver) Tags(ctx context.Context, query *string, limit *int) ([]*entity.Tag, error) {
 	return r.Uexky.SearchTags(ctx, query, limit)
 }
+
+func (r *queryResolver) TrendingTags(ctx context.Context, window entity.TrendingWindow, limit *int) ([]*entity.Tag, error) {
+	return r.Uexky.GetTrendingTags(ctx, window, limit)
+}
@@ -5,6 +5,7 @@ package graph
 
 import (
 	"context"
+	"time"
 
 	"gitlab.com/abyss.club/uexky/graph/generated"
 	"gitlab.com/abyss.club/uexky/lib/uid"
@@ -15,20 +16,60 @@ func (r *mutationResolver) PubThread(ctx context.Context, thread entity.ThreadIn
 	return r.Uexky.PubThread(ctx, thread)
 }
 
-func (r *mutationResolver) LockThread(ctx context.Context, threadID uid.UID) (*entity.Thread, error) {
-	return r.Uexky.LockThread(ctx, threadID)
+func (r *mutationResolver) EditThread(ctx context.Context, threadID uid.UID, title *string, content string) (*entity.Thread, error) {
+	return r.Uexky.EditThread(ctx, threadID, title, content)
 }
 
-func (r *mutationResolver) BlockThread(ctx context.Context, threadID uid.UID) (*entity.Thread, error) {
-	return r.Uexky.BlockThread(ctx, threadID)
+func (r *mutationResolver) WatchThread(ctx context.Context, threadID uid.UID) (*entity.Thread, error) {
+	return r.Uexky.WatchThread(ctx, threadID)
 }
 
-func (r *mutationResolver) EditTags(ctx context.Context, threadID uid.UID, mainTag string, subTags []string) (*entity.Thread, error) {
-	return r.Uexky.EditTags(ctx, threadID, mainTag, subTags)
+func (r *mutationResolver) UnwatchThread(ctx context.Context, threadID uid.UID) (*entity.Thread, error) {
+	return r.Uexky.UnwatchThread(ctx, threadID)
 }
 
-func (r *queryResolver) ThreadSlice(ctx context.Context, tags []string, query entity.SliceQuery) (*entity.ThreadSlice, error) {
-	return r.Uexky.SearchThreads(ctx, tags, query)
+func (r *mutationResolver) HideThread(ctx context.Context, threadID uid.UID) (*entity.Thread, error) {
+	return r.Uexky.HideThread(ctx, threadID)
+}
+
+func (r *mutationResolver) UnhideThread(ctx context.Context, threadID uid.UID) (*entity.Thread, error) {
+	return r.Uexky.UnhideThread(ctx, threadID)
+}
+
+func (r *mutationResolver) LockThread(ctx context.Context, threadID uid.UID, reason *string) (*entity.Thread, error) {
+	return r.Uexky.LockThread(ctx, threadID, reason)
+}
+
+func (r *mutationResolver) UnlockThread(ctx context.Context, threadID uid.UID, reason *string) (*entity.Thread, error) {
+	return r.Uexky.UnlockThread(ctx, threadID, reason)
+}
+
+func (r *mutationResolver) BlockThread(ctx context.Context, threadID uid.UID, reason *string) (*entity.Thread, error) {
+	return r.Uexky.BlockThread(ctx, threadID, reason)
+}
+
+func (r *mutationResolver) UnblockThread(ctx context.Context, threadID uid.UID, reason *string) (*entity.Thread, error) {
+	return r.Uexky.UnblockThread(ctx, threadID, reason)
+}
+
+func (r *mutationResolver) PinThread(ctx context.Context, threadID uid.UID, until *time.Time, reason *string) (*entity.Thread, error) {
+	return r.Uexky.PinThread(ctx, threadID, until, reason)
+}
+
+func (r *mutationResolver) UnpinThread(ctx context.Context, threadID uid.UID, reason *string) (*entity.Thread, error) {
+	return r.Uexky.UnpinThread(ctx, threadID, reason)
+}
+
+func (r *mutationResolver) EditTags(ctx context.Context, threadID uid.UID, mainTag string, subTags []string, reason *string) (*entity.Thread, error) {
+	return r.Uexky.EditTags(ctx, threadID, mainTag, subTags, reason)
+}
+
+func (r *queryResolver) ThreadSlice(ctx context.Context, tags []string, sort *entity.ThreadSort, query entity.SliceQuery) (*entity.ThreadSlice, error) {
+	return r.Uexky.SearchThreads(ctx, tags, sort, query)
+}
+
+func (r *queryResolver) Timeline(ctx context.Context, query entity.SliceQuery) (*entity.ThreadSlice, error) {
+	return r.Uexky.GetTimeline(ctx, query)
 }
 
 func (r *queryResolver) Thread(ctx context.Context, id uid.UID) (*entity.Thread, error) {
@@ -47,6 +88,18 @@ func (r *threadResolver) Catalog(ctx context.Context, obj *entity.Thread) ([]*en
 	return r.Uexky.GetThreadCatalog(ctx, obj)
 }
 
+func (r *threadResolver) Watched(ctx context.Context, obj *entity.Thread) (bool, error) {
+	return r.Uexky.GetThreadWatched(ctx, obj)
+}
+
+func (r *threadResolver) Bookmarked(ctx context.Context, obj *entity.Thread) (bool, error) {
+	return r.Uexky.GetBookmarked(ctx, obj.ID)
+}
+
+func (r *threadResolver) Revisions(ctx context.Context, obj *entity.Thread) ([]*entity.Revision, error) {
+	return r.Uexky.GetRevisions(ctx, obj.ID)
+}
+
 // Thread returns generated.ThreadResolver implementation.
 func (r *Resolver) Thread() generated.ThreadResolver { return &threadResolver{r} }
 
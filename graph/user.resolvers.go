@@ -36,8 +36,28 @@ func (r *mutationResolver) DelSubbedTag(ctx context.Context, tag string) (*entit
 	return r.Uexky.DelUserSubbedTag(ctx, tag)
 }
 
-func (r *mutationResolver) BanUser(ctx context.Context, postID *uid.UID, threadID *uid.UID) (bool, error) {
-	return r.Uexky.BanUser(ctx, postID, threadID)
+func (r *mutationResolver) MuteTag(ctx context.Context, tag string) (*entity.User, error) {
+	return r.Uexky.MuteTag(ctx, tag)
+}
+
+func (r *mutationResolver) UnmuteTag(ctx context.Context, tag string) (*entity.User, error) {
+	return r.Uexky.UnmuteTag(ctx, tag)
+}
+
+func (r *mutationResolver) SetAutoWatch(ctx context.Context, enable bool) (*entity.User, error) {
+	return r.Uexky.SetAutoWatch(ctx, enable)
+}
+
+func (r *mutationResolver) BanUser(ctx context.Context, postID *uid.UID, threadID *uid.UID, reason *string, duration *int) (bool, error) {
+	return r.Uexky.BanUser(ctx, postID, threadID, reason, duration)
+}
+
+func (r *mutationResolver) UnbanUser(ctx context.Context, postID *uid.UID, threadID *uid.UID, reason *string) (bool, error) {
+	return r.Uexky.UnbanUser(ctx, postID, threadID, reason)
+}
+
+func (r *mutationResolver) SetUserRole(ctx context.Context, email *string, userID *uid.UID, role entity.Role, tag *string, reason *string) (*entity.User, error) {
+	return r.Uexky.SetUserRole(ctx, email, userID, role, tag, reason)
 }
 
 func (r *queryResolver) Profile(ctx context.Context) (*entity.User, error) {
@@ -52,6 +72,14 @@ func (r *userResolver) Posts(ctx context.Context, obj *entity.User, query entity
 	return r.Uexky.GetUserPosts(ctx, obj, query)
 }
 
+func (r *userResolver) Bookmarks(ctx context.Context, obj *entity.User, query entity.SliceQuery) (*entity.BookmarkSlice, error) {
+	return r.Uexky.GetUserBookmarks(ctx, obj, query)
+}
+
+func (r *userResolver) HiddenThreads(ctx context.Context, obj *entity.User, query entity.SliceQuery) (*entity.ThreadSlice, error) {
+	return r.Uexky.GetUserHiddenThreads(ctx, obj, query)
+}
+
 // User returns generated.UserResolver implementation.
 func (r *Resolver) User() generated.UserResolver { return &userResolver{r} }
 
@@ -21,3 +21,11 @@ func NullToIntDefault(i *int, def int) int {
 	}
 	return *i
 }
+
+func NullBool(b bool) *bool {
+	return &b
+}
+
+func NullToBool(b *bool) bool {
+	return b != nil && *b
+}
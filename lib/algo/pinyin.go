@@ -0,0 +1,37 @@
+package algo
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/mozillazg/go-pinyin"
+)
+
+var pinyinArgs = pinyin.NewArgs()
+
+// Pinyin returns pinyin without tones of Chinese characters in s and the initials of them, other characters
+// are kept in lower case. Heteronyms use the most common pronunciation. Both are empty if s has no Chinese.
+func Pinyin(s string) (full, initials string) {
+	var fb, ib strings.Builder
+	hasHan := false
+	for _, r := range s {
+		if unicode.Is(unicode.Han, r) {
+			if py := pinyin.SinglePinyin(r, pinyinArgs); len(py) > 0 && py[0] != "" {
+				hasHan = true
+				fb.WriteString(py[0])
+				ib.WriteString(py[0][:1])
+				continue
+			}
+		}
+		if unicode.IsSpace(r) {
+			continue
+		}
+		lr := unicode.ToLower(r)
+		fb.WriteRune(lr)
+		ib.WriteRune(lr)
+	}
+	if !hasHan {
+		return "", ""
+	}
+	return fb.String(), ib.String()
+}
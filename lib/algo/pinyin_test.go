@@ -0,0 +1,24 @@
+package algo
+
+import "testing"
+
+func TestPinyin(t *testing.T) {
+	tests := []struct {
+		s            string
+		wantFull     string
+		wantInitials string
+	}{
+		{s: "原神", wantFull: "yuanshen", wantInitials: "ys"},
+		{s: "原神 Impact", wantFull: "yuanshenimpact", wantInitials: "ysimpact"},
+		{s: "Genshin", wantFull: "", wantInitials: ""},
+		{s: "", wantFull: "", wantInitials: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			full, initials := Pinyin(tt.s)
+			if full != tt.wantFull || initials != tt.wantInitials {
+				t.Errorf("Pinyin(%q) = (%q, %q), want (%q, %q)", tt.s, full, initials, tt.wantFull, tt.wantInitials)
+			}
+		})
+	}
+}
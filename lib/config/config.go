@@ -27,17 +27,28 @@ type Config struct {
 		Domain     string `toml:"domain"`
 	} `toml:"mail"`
 	RateLimit struct {
-		HTTPHeader     string `toml:"http_header"`
-		QueryLimit     int    `toml:"query_limit"`
-		QueryResetTime int    `toml:"query_reset_time"`
-		MutLimit       int    `toml:"mut_limit"`
-		MutResetTime   int    `toml:"mut_reset_time"`
+		HTTPHeader string `toml:"http_header"`
+		// TrustedProxies is the count of proxies appending client ip to HTTPHeader, such as X-Forwarded-For.
+		// Entries on the left of them are sent by client and can't be trusted.
+		TrustedProxies int `toml:"trusted_proxies"`
+		QueryLimit     int `toml:"query_limit"`
+		QueryResetTime int `toml:"query_reset_time"`
+		MutLimit       int `toml:"mut_limit"`
+		MutResetTime   int `toml:"mut_reset_time"`
+		// OperationLimit is the max query cost of one graphql operation.
+		OperationLimit int `toml:"operation_limit"`
 		Cost           struct {
 			CreateUser int `toml:"create_user"`
 			PubThread  int `toml:"pub_thread"`
 			PubPost    int `toml:"pub_post"`
 		} `toml:"cost"`
 	} `toml:"rate_limit"`
+	// AnonymousIDSecret is the key to derive anonymous ids of users, keep it secret and unchanged.
+	AnonymousIDSecret string `toml:"anonymous_id_secret"`
+	// EditWindow is the time in seconds that authors can edit their threads and posts after publishing.
+	EditWindow int `toml:"edit_window"`
+	// TrendingRefresh is the interval in seconds to refresh trending tags.
+	TrendingRefresh int `toml:"trending_refresh"`
 
 	filename string `toml:"-"`
 }
@@ -54,6 +65,17 @@ func setDefault() {
 	c.Server.Proto = "http"
 	c.Server.Port = 8000
 	c.Server.Host = "localhost"
+	c.RateLimit.QueryLimit = 3000
+	c.RateLimit.QueryResetTime = 600
+	c.RateLimit.TrustedProxies = 1
+	c.RateLimit.MutLimit = 60
+	c.RateLimit.MutResetTime = 600
+	c.RateLimit.OperationLimit = 50
+	c.RateLimit.Cost.CreateUser = 20
+	c.RateLimit.Cost.PubThread = 10
+	c.RateLimit.Cost.PubPost = 2
+	c.EditWindow = 1800
+	c.TrendingRefresh = 600
 }
 
 func patchEnv() {
@@ -61,6 +83,7 @@ func patchEnv() {
 	c.PostgresURI = getenv("PG_URI", c.PostgresURI)
 	c.RedisURI = getenv("REDIS_URI", c.RedisURI)
 	c.MigrationFiles = getenv("MIGRATION_FILES", c.MigrationFiles)
+	c.AnonymousIDSecret = getenv("ANONYMOUS_ID_SECRET", c.AnonymousIDSecret)
 	c.Server.Domain = getenv("DOMAIN", c.Server.Domain)
 	c.Server.APIDomain = getenv("API_DOMAIN", c.Server.APIDomain)
 	c.Server.Proto = getenv("PROTO", c.Server.Proto)
@@ -69,6 +92,8 @@ func patchEnv() {
 	c.Mail.PrivateKey = getenv("MAILGUN_PRIVATE_KEY", c.Mail.PrivateKey)
 	c.Mail.PublicKey = getenv("MAILGUN_PUBLIC_KEY", c.Mail.PublicKey)
 	c.Mail.Domain = getenv("MAILGUN_DOMAIN", c.Mail.Domain)
+	c.RateLimit.HTTPHeader = getenv("RATE_LIMIT_HTTP_HEADER", c.RateLimit.HTTPHeader)
+	c.RateLimit.TrustedProxies = getenvInt("RATE_LIMIT_TRUSTED_PROXIES", c.RateLimit.TrustedProxies)
 }
 
 func Load(filename string) error {
@@ -92,6 +117,14 @@ func Load(filename string) error {
 	return nil
 }
 
+// CheckServing checks configurations required to serve requests, which other commands can run without.
+func CheckServing() error {
+	if c.AnonymousIDSecret == "" {
+		return errors.BadParams.New("anonymous_id_secret must be set, anonymous ids are unkeyed without it")
+	}
+	return nil
+}
+
 func Get() *Config {
 	return &c
 }
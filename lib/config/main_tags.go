@@ -1,31 +1,44 @@
 package config
 
-// main tags is a config, load from database
+import "sync"
+
+// main tags is a config, load from database, and reloaded when changed at runtime.
+
+var mainTagsMu sync.RWMutex
 
 var mainTags []string
 
 var mainTagSet map[string]bool
 
+// SetMainTags replaces main tags, slices returned by GetMainTags before are not modified.
 func SetMainTags(tags []string) {
-	mainTags = []string{}
-	mainTagSet = map[string]bool{}
+	newTags := []string{}
+	newSet := map[string]bool{}
 	for _, t := range tags {
-		if !mainTagSet[t] {
-			mainTags = append(mainTags, t)
-			mainTagSet[t] = true
+		if !newSet[t] {
+			newTags = append(newTags, t)
+			newSet[t] = true
 		}
 	}
+	mainTagsMu.Lock()
+	defer mainTagsMu.Unlock()
+	mainTags = newTags
+	mainTagSet = newSet
 }
 
 func GetMainTags() []string {
+	mainTagsMu.RLock()
+	defer mainTagsMu.RUnlock()
 	return mainTags
 }
 
 func MainTags() []string {
-	return mainTags
+	return GetMainTags()
 }
 
 func SplitTags(tags ...string) (mains []string, subs []string) {
+	mainTagsMu.RLock()
+	defer mainTagsMu.RUnlock()
 	repeat := map[string]bool{}
 	for _, tag := range tags {
 		if repeat[tag] {
@@ -43,5 +56,7 @@ func SplitTags(tags ...string) (mains []string, subs []string) {
 }
 
 func IsMainTag(tag string) bool {
+	mainTagsMu.RLock()
+	defer mainTagsMu.RUnlock()
 	return mainTagSet[tag]
 }
@@ -0,0 +1,38 @@
+package config
+
+import "sync"
+
+// tag aliases map merged tags to the canonical ones, load from database like main tags.
+
+var tagAliasesMu sync.RWMutex
+
+var tagAliases map[string]string
+
+func SetTagAliases(aliases map[string]string) {
+	newAliases := make(map[string]string, len(aliases))
+	for alias, tag := range aliases {
+		newAliases[alias] = tag
+	}
+	tagAliasesMu.Lock()
+	defer tagAliasesMu.Unlock()
+	tagAliases = newAliases
+}
+
+// CanonicalTag returns the tag which tag is merged into, or tag itself if it's not an alias.
+func CanonicalTag(tag string) string {
+	tagAliasesMu.RLock()
+	defer tagAliasesMu.RUnlock()
+	if t, ok := tagAliases[tag]; ok {
+		return t
+	}
+	return tag
+}
+
+// CanonicalTags replaces aliases in tags, the order is kept.
+func CanonicalTags(tags []string) []string {
+	canonical := make([]string, len(tags))
+	for i, tag := range tags {
+		canonical[i] = CanonicalTag(tag)
+	}
+	return canonical
+}
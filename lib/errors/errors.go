@@ -7,10 +7,14 @@ import (
 )
 
 type Error struct {
-	t ErrorType
-	e error
+	t   ErrorType
+	e   error
+	ext Extensions
 }
 
+// Extensions are extra fields presented to client along with the error code.
+type Extensions map[string]interface{}
+
 type ErrorType string
 
 const (
@@ -73,17 +77,28 @@ func (e *Error) Code() string {
 	return e.t.Code()
 }
 
+func (e *Error) Extensions() Extensions {
+	return e.ext
+}
+
+// With returns an error type carries extensions, e.g. errors.Complexity.With(ext).New("...")
+func (e *Error) With(ext Extensions) *Error {
+	return &Error{t: e.t, ext: ext}
+}
+
 func (e *Error) New(a ...interface{}) error {
 	return &Error{
-		t: e.t,
-		e: pkgerrors.New(fmt.Sprint(a...)),
+		t:   e.t,
+		e:   pkgerrors.New(fmt.Sprint(a...)),
+		ext: e.ext,
 	}
 }
 
 func (e *Error) Errorf(format string, a ...interface{}) error {
 	return &Error{
-		t: e.t,
-		e: pkgerrors.New(fmt.Sprintf(format, a...)),
+		t:   e.t,
+		e:   pkgerrors.New(fmt.Sprintf(format, a...)),
+		ext: e.ext,
 	}
 }
 
@@ -92,8 +107,9 @@ func (e *Error) Handle(err error, a ...interface{}) error {
 		return nil
 	}
 	return &Error{
-		t: e.t,
-		e: pkgerrors.Wrap(err, fmt.Sprint(a...)),
+		t:   e.t,
+		e:   pkgerrors.Wrap(err, fmt.Sprint(a...)),
+		ext: e.ext,
 	}
 }
 
@@ -102,8 +118,9 @@ func (e *Error) Handlef(err error, format string, a ...interface{}) error {
 		return nil
 	}
 	return &Error{
-		t: e.t,
-		e: pkgerrors.Wrapf(err, format, a...),
+		t:   e.t,
+		e:   pkgerrors.Wrapf(err, format, a...),
+		ext: e.ext,
 	}
 }
 
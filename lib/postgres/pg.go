@@ -42,9 +42,10 @@ type contextKey int
 const pgDataKey contextKey = 1
 
 type PgContextData struct {
-	DB    *pg.DB
-	Tx    *pg.Tx
-	Layer int
+	DB *pg.DB
+	Tx *pg.Tx
+
+	afterCommit []func()
 }
 
 func getData(ctx context.Context) *PgContextData {
@@ -56,7 +57,7 @@ func getData(ctx context.Context) *PgContextData {
 }
 
 func (d *PgContextData) GetSession() Session {
-	if d.Tx != nil && d.Layer > 0 {
+	if d.Tx != nil {
 		return d.Tx
 	}
 	return d.DB
@@ -66,6 +67,9 @@ func (tx *TxAdapter) AttachDB(ctx context.Context) context.Context {
 	return context.WithValue(ctx, pgDataKey, &PgContextData{DB: tx.DB})
 }
 
+// WithTx runs fn in a transaction, statements of sessions got from ctx are run in it.
+// fn must return the errors of statements: a failed statement aborts the transaction,
+// and Postgres answers COMMIT of an aborted transaction with a silent ROLLBACK.
 func (tx *TxAdapter) WithTx(ctx context.Context, fn func() error) error {
 	data := getData(ctx)
 	if data.Tx != nil {
@@ -78,6 +82,10 @@ func (tx *TxAdapter) WithTx(ctx context.Context, fn func() error) error {
 		return errors.Postgres.Handle(err, "begin transaction")
 	}
 	data.Tx = transaction
+	defer func() {
+		data.Tx = nil
+		data.afterCommit = nil
+	}()
 
 	if err := fn(); err != nil {
 		rbErr := data.Tx.Rollback()
@@ -91,9 +99,23 @@ func (tx *TxAdapter) WithTx(ctx context.Context, fn func() error) error {
 	if err := data.Tx.Commit(); err != nil {
 		return errors.Postgres.Handle(err, "commit transaction")
 	}
+	for _, f := range data.afterCommit {
+		f()
+	}
 	return nil
 }
 
+// AfterCommit runs fn after the transaction in context committed, fn is dropped if it's rolled back.
+// If there is no transaction, fn runs immediately.
+func AfterCommit(ctx context.Context, fn func()) {
+	data := getData(ctx)
+	if data.Tx == nil {
+		fn()
+		return
+	}
+	data.afterCommit = append(data.afterCommit, fn)
+}
+
 func ErrHandle(err error, a ...interface{}) error {
 	if errors.Is(err, pg.ErrNoRows) {
 		return errors.NotFound.Handle(err, a...)
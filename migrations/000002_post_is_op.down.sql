@@ -0,0 +1 @@
+ALTER TABLE public.post DROP COLUMN is_op;
@@ -0,0 +1,5 @@
+ALTER TABLE public.post ADD COLUMN is_op boolean NOT NULL DEFAULT false;
+
+UPDATE public.post SET is_op = true FROM public.thread
+    WHERE post.thread_id = thread.id AND post.user_id = thread.user_id
+    AND post.anonymous = thread.anonymous;
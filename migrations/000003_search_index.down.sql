@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS public.post_content_trgm_index;
+DROP INDEX IF EXISTS public.thread_content_trgm_index;
+DROP INDEX IF EXISTS public.thread_title_trgm_index;
@@ -0,0 +1,8 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- trigram indexes speed up ILIKE of any language, including CJK, if LC_CTYPE of the database is UTF-8.
+-- In C locale CJK characters produce no trigrams, the server checks it on startup.
+-- Terms shorter than 3 characters, which are common in Chinese, can't use the indexes and scan the tables.
+CREATE INDEX thread_title_trgm_index ON public.thread USING gin (title gin_trgm_ops);
+CREATE INDEX thread_content_trgm_index ON public.thread USING gin (content gin_trgm_ops);
+CREATE INDEX post_content_trgm_index ON public.post USING gin (content gin_trgm_ops);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS public.thread_hot_index;
+DROP INDEX IF EXISTS public.thread_reply_count_index;
+
+ALTER TABLE public.thread DROP COLUMN hot;
+ALTER TABLE public.thread DROP COLUMN reply_count;
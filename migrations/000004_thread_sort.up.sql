@@ -0,0 +1,20 @@
+ALTER TABLE public.thread ADD COLUMN reply_count integer NOT NULL DEFAULT 0;
+-- log of sum of weights of publishing and replies, weight doubles every day. See repo.hotScore.
+ALTER TABLE public.thread ADD COLUMN hot double precision NOT NULL DEFAULT 0;
+
+UPDATE public.thread SET reply_count = c.count FROM (
+    SELECT thread_id, count(*) AS count FROM public.post GROUP BY thread_id
+) AS c WHERE thread.id = c.thread_id;
+
+UPDATE public.thread SET hot = h.hot FROM (
+    SELECT thread_id, max(m) + ln(sum(exp(greatest(score - m, -700)))) AS hot FROM (
+        SELECT thread_id, score, max(score) OVER (PARTITION BY thread_id) AS m FROM (
+            SELECT id AS thread_id, extract(epoch FROM created_at) / 86400 * ln(2) AS score FROM public.thread
+            UNION ALL
+            SELECT thread_id, extract(epoch FROM created_at) / 86400 * ln(2) AS score FROM public.post
+        ) AS events
+    ) AS weighted GROUP BY thread_id
+) AS h WHERE thread.id = h.thread_id;
+
+CREATE INDEX thread_reply_count_index ON public.thread USING btree (reply_count, id);
+CREATE INDEX thread_hot_index ON public.thread USING btree (hot, id);
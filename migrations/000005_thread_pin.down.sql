@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS public.thread_pinned_index;
+
+ALTER TABLE public.thread DROP COLUMN pinned_until;
+ALTER TABLE public.thread DROP COLUMN pinned;
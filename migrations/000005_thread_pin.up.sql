@@ -0,0 +1,5 @@
+ALTER TABLE public.thread ADD COLUMN pinned boolean NOT NULL DEFAULT false;
+-- NULL means pinned forever
+ALTER TABLE public.thread ADD COLUMN pinned_until timestamp with time zone;
+
+CREATE INDEX thread_pinned_index ON public.thread USING btree ((tags[1])) WHERE pinned;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS public.revision;
+
+ALTER TABLE public.post DROP COLUMN edited_at;
+ALTER TABLE public.thread DROP COLUMN edited_at;
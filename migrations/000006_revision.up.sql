@@ -0,0 +1,13 @@
+ALTER TABLE public.thread ADD COLUMN edited_at timestamp with time zone;
+ALTER TABLE public.post ADD COLUMN edited_at timestamp with time zone;
+
+-- previous versions of edited threads and posts
+CREATE TABLE public.revision (
+    id bigint PRIMARY KEY,
+    target_id bigint NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    title text,
+    content text NOT NULL
+);
+
+CREATE INDEX revision_target_index ON public.revision USING btree (target_id, id);
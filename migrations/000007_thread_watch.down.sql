@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS public.thread_watch;
+
+ALTER TABLE public."user" DROP COLUMN auto_watch;
@@ -0,0 +1,17 @@
+ALTER TABLE public."user" ADD COLUMN auto_watch boolean NOT NULL DEFAULT true;
+
+CREATE TABLE public.thread_watch (
+    thread_id bigint NOT NULL,
+    user_id bigint NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    PRIMARY KEY (thread_id, user_id)
+);
+
+CREATE INDEX thread_watch_user_index ON public.thread_watch USING btree (user_id);
+
+-- authors keep receiving replied notifications, repliers watch the threads they replied to.
+INSERT INTO public.thread_watch (thread_id, user_id)
+    SELECT id, user_id FROM public.thread WHERE NOT guest
+    UNION
+    SELECT thread_id, user_id FROM public.post WHERE NOT guest
+    ON CONFLICT DO NOTHING;
@@ -0,0 +1,11 @@
+-- user_id is not a foreign key, guests are stored in redis.
+CREATE TABLE public.bookmark (
+    user_id bigint NOT NULL,
+    target_id bigint NOT NULL,
+    kind text NOT NULL,
+    sort_key bigint NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    PRIMARY KEY (user_id, target_id)
+);
+
+CREATE INDEX bookmark_user_sort_key_index ON public.bookmark USING btree (user_id, sort_key);
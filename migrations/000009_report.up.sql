@@ -0,0 +1,17 @@
+CREATE TABLE public.report (
+    id bigint PRIMARY KEY,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    reporter_id bigint NOT NULL,
+    -- post_id if a post is reported, thread_id otherwise
+    target_id bigint NOT NULL,
+    thread_id bigint NOT NULL,
+    post_id bigint,
+    reason text NOT NULL,
+    text text,
+    status text NOT NULL,
+    handler_id bigint,
+    handled_at timestamp with time zone,
+    UNIQUE (reporter_id, target_id)
+);
+
+CREATE INDEX report_status_index ON public.report USING btree (status, id);
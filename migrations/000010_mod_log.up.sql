@@ -0,0 +1,13 @@
+-- append-only, never update or delete rows.
+CREATE TABLE public.mod_log (
+    id bigint PRIMARY KEY,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    actor_id bigint NOT NULL,
+    action text NOT NULL,
+    target_id bigint NOT NULL,
+    reason text,
+    before jsonb NOT NULL,
+    after jsonb NOT NULL
+);
+
+CREATE INDEX mod_log_target_index ON public.mod_log USING btree (target_id);
@@ -0,0 +1 @@
+ALTER TABLE public."user" ADD COLUMN prev_role text;
@@ -0,0 +1,2 @@
+ALTER TABLE public."user" DROP COLUMN ban_expires_at;
+ALTER TABLE public."user" DROP COLUMN ban_reason;
@@ -0,0 +1,2 @@
+ALTER TABLE public."user" ADD COLUMN ban_reason text;
+ALTER TABLE public."user" ADD COLUMN ban_expires_at timestamp with time zone;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS public.net_ban;
+
+ALTER TABLE public.post DROP COLUMN ip;
+ALTER TABLE public.thread DROP COLUMN ip;
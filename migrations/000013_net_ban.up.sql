@@ -0,0 +1,14 @@
+ALTER TABLE public.thread ADD COLUMN ip inet;
+ALTER TABLE public.post ADD COLUMN ip inet;
+
+CREATE TABLE public.net_ban (
+    id bigint PRIMARY KEY,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    network cidr NOT NULL,
+    target_id bigint NOT NULL,
+    creator_id bigint NOT NULL,
+    reason text,
+    expires_at timestamp with time zone
+);
+
+CREATE INDEX net_ban_network_index ON public.net_ban USING gist (network inet_ops);
@@ -0,0 +1 @@
+ALTER TABLE public."user" ADD COLUMN tag_roles jsonb;
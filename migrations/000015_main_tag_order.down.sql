@@ -0,0 +1,2 @@
+UPDATE public.tag SET type = 'main' WHERE type = 'retired';
+ALTER TABLE public.tag DROP COLUMN sort_order;
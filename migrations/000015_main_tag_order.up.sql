@@ -0,0 +1,5 @@
+ALTER TABLE public.tag ADD COLUMN sort_order integer NOT NULL DEFAULT 0;
+UPDATE public.tag SET sort_order = o.sort_order FROM (
+    SELECT name, row_number() OVER (ORDER BY created_at, name) - 1 AS sort_order
+    FROM public.tag WHERE type = 'main'
+) AS o WHERE tag.name = o.name;
@@ -0,0 +1 @@
+DROP TABLE public.tag_alias;
@@ -0,0 +1,6 @@
+CREATE TABLE public.tag_alias (
+    alias text PRIMARY KEY,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    tag text NOT NULL
+);
+CREATE INDEX tag_alias_tag_index ON public.tag_alias (tag);
@@ -0,0 +1,4 @@
+DROP TRIGGER thread_tag_stat_update ON public.thread;
+DROP TRIGGER thread_tag_stat ON public.thread;
+DROP FUNCTION update_tag_stat();
+DROP TABLE public.tag_stat;
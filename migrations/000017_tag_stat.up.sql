@@ -0,0 +1,47 @@
+CREATE TABLE public.tag_stat (
+    name text PRIMARY KEY,
+    thread_count integer NOT NULL DEFAULT 0,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    -- filled by uexky, NULL means not indexed yet, empty if the tag has no Chinese.
+    pinyin text,
+    initials text
+);
+CREATE INDEX tag_stat_name_trgm_index ON public.tag_stat USING gin (lower(name) gin_trgm_ops);
+CREATE INDEX tag_stat_pinyin_index ON public.tag_stat (pinyin text_pattern_ops);
+CREATE INDEX tag_stat_initials_index ON public.tag_stat (initials text_pattern_ops);
+CREATE INDEX tag_stat_not_indexed_index ON public.tag_stat (name) WHERE pinyin IS NULL;
+
+INSERT INTO public.tag_stat (name, thread_count, updated_at)
+    SELECT tag, count(*), max(created_at) FROM public.thread, unnest(tags) AS tag GROUP BY tag;
+-- main tags are searchable without threads.
+INSERT INTO public.tag_stat (name, thread_count)
+    SELECT name, 0 FROM public.tag WHERE type = 'main'
+    ON CONFLICT (name) DO NOTHING;
+
+CREATE OR REPLACE FUNCTION update_tag_stat()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        UPDATE public.tag_stat SET thread_count = thread_count - 1 WHERE name = ANY(OLD.tags);
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        INSERT INTO public.tag_stat (name, thread_count, updated_at)
+            SELECT DISTINCT unnest(NEW.tags), 1, NEW.created_at
+            ON CONFLICT (name) DO UPDATE SET
+                thread_count = tag_stat.thread_count + 1,
+                updated_at = greatest(tag_stat.updated_at, excluded.updated_at);
+    END IF;
+    return NULL;
+end;
+$$ language 'plpgsql';
+
+CREATE TRIGGER thread_tag_stat
+    after insert or delete on public.thread
+    for each row
+    execute procedure update_tag_stat();
+
+CREATE TRIGGER thread_tag_stat_update
+    after update of tags on public.thread
+    for each row
+    when (OLD.tags IS DISTINCT FROM NEW.tags)
+    execute procedure update_tag_stat();
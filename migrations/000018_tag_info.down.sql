@@ -0,0 +1,28 @@
+DROP TRIGGER post_tag_active_at ON public.post;
+DROP FUNCTION update_tag_active_at();
+DROP TRIGGER user_tag_stat_update ON public."user";
+DROP TRIGGER user_tag_stat ON public."user";
+DROP FUNCTION update_tag_subscriber_count();
+
+CREATE OR REPLACE FUNCTION update_tag_stat()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        UPDATE public.tag_stat SET thread_count = thread_count - 1 WHERE name = ANY(OLD.tags);
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        INSERT INTO public.tag_stat (name, thread_count, updated_at)
+            SELECT DISTINCT unnest(NEW.tags), 1, NEW.created_at
+            ON CONFLICT (name) DO UPDATE SET
+                thread_count = tag_stat.thread_count + 1,
+                updated_at = greatest(tag_stat.updated_at, excluded.updated_at);
+    END IF;
+    return NULL;
+end;
+$$ language 'plpgsql';
+
+ALTER TABLE public.tag_stat DROP COLUMN subscriber_count;
+UPDATE public.tag_stat SET active_at = now() WHERE active_at IS NULL;
+ALTER TABLE public.tag_stat ALTER COLUMN active_at SET NOT NULL, ALTER COLUMN active_at SET DEFAULT now();
+ALTER TABLE public.tag_stat RENAME COLUMN active_at TO updated_at;
+ALTER TABLE public.tag DROP COLUMN description, DROP COLUMN rules;
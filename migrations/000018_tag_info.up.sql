@@ -0,0 +1,74 @@
+ALTER TABLE public.tag ADD COLUMN description text, ADD COLUMN rules text;
+
+-- active_at is the time of the last thread or reply with the tag, NULL if the tag is only subscribed.
+ALTER TABLE public.tag_stat RENAME COLUMN updated_at TO active_at;
+ALTER TABLE public.tag_stat ALTER COLUMN active_at DROP NOT NULL, ALTER COLUMN active_at DROP DEFAULT;
+ALTER TABLE public.tag_stat ADD COLUMN subscriber_count integer NOT NULL DEFAULT 0;
+
+UPDATE public.tag_stat SET active_at = greatest(tag_stat.active_at, a.active_at) FROM (
+    SELECT tag, max(p.created_at) AS active_at
+    FROM public.post AS p JOIN public.thread AS t ON t.id = p.thread_id, unnest(t.tags) AS tag GROUP BY tag
+) AS a WHERE tag_stat.name = a.tag;
+INSERT INTO public.tag_stat (name, subscriber_count)
+    SELECT tag, count(*) FROM public."user", unnest(tags) AS tag GROUP BY tag
+    ON CONFLICT (name) DO UPDATE SET subscriber_count = excluded.subscriber_count;
+
+CREATE OR REPLACE FUNCTION update_tag_stat()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        UPDATE public.tag_stat SET thread_count = thread_count - 1 WHERE name = ANY(OLD.tags);
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        INSERT INTO public.tag_stat (name, thread_count, active_at)
+            SELECT DISTINCT unnest(NEW.tags), 1, NEW.created_at
+            ON CONFLICT (name) DO UPDATE SET
+                thread_count = tag_stat.thread_count + 1,
+                active_at = greatest(tag_stat.active_at, excluded.active_at);
+    END IF;
+    return NULL;
+end;
+$$ language 'plpgsql';
+
+CREATE OR REPLACE FUNCTION update_tag_subscriber_count()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        UPDATE public.tag_stat SET subscriber_count = subscriber_count - 1 WHERE name = ANY(OLD.tags);
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        INSERT INTO public.tag_stat (name, subscriber_count)
+            SELECT DISTINCT unnest(NEW.tags), 1
+            ON CONFLICT (name) DO UPDATE SET subscriber_count = tag_stat.subscriber_count + 1;
+    END IF;
+    return NULL;
+end;
+$$ language 'plpgsql';
+
+CREATE TRIGGER user_tag_stat
+    after insert or delete on public."user"
+    for each row
+    execute procedure update_tag_subscriber_count();
+
+CREATE TRIGGER user_tag_stat_update
+    after update of tags on public."user"
+    for each row
+    when (OLD.tags IS DISTINCT FROM NEW.tags)
+    execute procedure update_tag_subscriber_count();
+
+-- Rows of busy tags are locked until the transaction publishing the post ends, so active_at is only
+-- written when it moves forward by a minute, replies in the same tag don't queue behind each other.
+CREATE OR REPLACE FUNCTION update_tag_active_at()
+RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE public.tag_stat SET active_at = NEW.created_at
+        WHERE name = ANY(SELECT unnest(tags) FROM public.thread WHERE id = NEW.thread_id)
+        AND (active_at IS NULL OR active_at < NEW.created_at - interval '1 minute');
+    return NULL;
+end;
+$$ language 'plpgsql';
+
+CREATE TRIGGER post_tag_active_at
+    after insert on public.post
+    for each row
+    execute procedure update_tag_active_at();
@@ -0,0 +1,2 @@
+DROP TABLE public.hidden_thread;
+ALTER TABLE public."user" DROP COLUMN muted_tags;
@@ -0,0 +1,12 @@
+ALTER TABLE public."user" ADD COLUMN muted_tags text[];
+
+-- user_id is not a foreign key, guests are stored in redis.
+CREATE TABLE public.hidden_thread (
+    user_id bigint NOT NULL,
+    thread_id bigint NOT NULL,
+    sort_key bigint NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    PRIMARY KEY (user_id, thread_id)
+);
+
+CREATE INDEX hidden_thread_user_sort_key_index ON public.hidden_thread USING btree (user_id, sort_key);
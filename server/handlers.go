@@ -5,9 +5,13 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 	"gitlab.com/abyss.club/uexky/auth"
 	"gitlab.com/abyss.club/uexky/graph/generated"
@@ -27,6 +31,11 @@ func (s *Server) AuthHandler(w http.ResponseWriter, req *http.Request) {
 	var token *auth.Token
 	var err error
 	if guest != "" {
+		// guests in banned network can't get a new token to escape from the ban.
+		if err := s.Resolver.Uexky.CheckNetBan(req.Context(), realIP(req)); err != nil {
+			writeError(w, err)
+			return
+		}
 		token, err = s.Resolver.Auth.SignInGuestUser(req.Context())
 	} else {
 		token, err = s.Resolver.Auth.SignInByCode(req.Context(), auth.Code(code))
@@ -46,25 +55,46 @@ func (s *Server) AuthHandler(w http.ResponseWriter, req *http.Request) {
 }
 
 func (s *Server) GraphQLHandler() http.Handler {
-	server := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{
+	server := handler.New(generated.NewExecutableSchema(generated.Config{
 		Resolvers: s.Resolver,
 	}))
-	server.SetErrorPresenter(func(ctx context.Context, err error) *gqlerror.Error {
-		path := graphql.GetFieldContext(ctx).Path()
-		message := err.Error()
-		uerr := &errors.Error{}
-		var code string
-		if errors.As(err, &uerr) {
-			code = uerr.Code()
-		} else {
-			code = errors.Internal.Code()
-		}
-		gerr := gqlerror.ErrorPathf(path, message)
-		gerr.Extensions = map[string]interface{}{
-			"code":       code,
-			"stacktrace": strings.Split(fmt.Sprintf("%+v", err), "\n"),
-		}
-		return gerr
+	// websocket transport serves subscriptions, the upgrading request has passed through middlewares.
+	// Operations on one socket run concurrently with its context, see aroundOperations.
+	server.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
 	})
+	server.AddTransport(transport.Options{})
+	server.AddTransport(transport.GET{})
+	server.AddTransport(transport.POST{})
+	server.AddTransport(transport.MultipartForm{})
+	server.AroundOperations(s.aroundOperations)
+	server.SetQueryCache(lru.New(1000))
+	server.Use(extension.Introspection{})
+	server.Use(extension.AutomaticPersistedQuery{
+		Cache: lru.New(100),
+	})
+	server.SetErrorPresenter(presentError)
 	return server
 }
+
+// presentError adds code and extensions of errors.Error to graphql error.
+func presentError(ctx context.Context, err error) *gqlerror.Error {
+	path := graphql.GetFieldContext(ctx).Path()
+	message := err.Error()
+	uerr := &errors.Error{}
+	var code string
+	if errors.As(err, &uerr) {
+		code = uerr.Code()
+	} else {
+		code = errors.Internal.Code()
+	}
+	gerr := gqlerror.ErrorPathf(path, message)
+	gerr.Extensions = map[string]interface{}{
+		"code":       code,
+		"stacktrace": strings.Split(fmt.Sprintf("%+v", err), "\n"),
+	}
+	for k, v := range uerr.Extensions() {
+		gerr.Extensions[k] = v
+	}
+	return gerr
+}
@@ -0,0 +1,116 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/gorilla/websocket"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"gitlab.com/abyss.club/uexky/graph"
+	"gitlab.com/abyss.club/uexky/uexky"
+)
+
+type fakeDBKey struct{}
+
+// fakeTxAdapter attaches a numbered db session, so that tests can tell sessions apart.
+type fakeTxAdapter struct {
+	count int32
+}
+
+func (tx *fakeTxAdapter) AttachDB(ctx context.Context) context.Context {
+	return context.WithValue(ctx, fakeDBKey{}, atomic.AddInt32(&tx.count, 1))
+}
+
+func (tx *fakeTxAdapter) WithTx(ctx context.Context, fn func() error) error {
+	return fn()
+}
+
+func TestServer_aroundOperations(t *testing.T) {
+	s := &Server{
+		Resolver:  &graph.Resolver{Uexky: &uexky.Service{}},
+		TxAdapter: &fakeTxAdapter{},
+	}
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		type Query { db: Int! }
+		type Subscription { db: Int! }
+	`})
+	started := &sync.WaitGroup{}
+	started.Add(2)
+	server := handler.New(&graphql.ExecutableSchemaMock{
+		ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
+			sent := false
+			return func(ctx context.Context) *graphql.Response {
+				if sent {
+					<-ctx.Done()
+					return nil
+				}
+				sent = true
+				// both operations are running before any of them responds.
+				started.Done()
+				started.Wait()
+				return &graphql.Response{
+					Data: []byte(fmt.Sprintf(`{"db":%v}`, ctx.Value(fakeDBKey{}))),
+				}
+			}
+		},
+		SchemaFunc: func() *ast.Schema { return schema },
+	})
+	server.AddTransport(transport.Websocket{})
+	server.AroundOperations(s.aroundOperations)
+	ts := httptest.NewServer(s.withDB(server))
+	defer ts.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial(strings.Replace(ts.URL, "http", "ws", 1), nil)
+	if err != nil {
+		t.Fatalf("dial websocket: %v", err)
+	}
+	defer conn.Close()
+	if err := conn.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	send := func(msg string) {
+		if err := conn.WriteMessage(websocket.TextMessage, []byte(msg)); err != nil {
+			t.Fatalf("send %s: %v", msg, err)
+		}
+	}
+	send(`{"type":"connection_init"}`)
+	send(`{"id":"1","type":"start","payload":{"query":"subscription { db }"}}`)
+	send(`{"id":"2","type":"start","payload":{"query":"subscription { db }"}}`)
+
+	sessions := map[string]int{}
+	for len(sessions) < 2 {
+		var msg struct {
+			ID      string `json:"id"`
+			Type    string `json:"type"`
+			Payload struct {
+				Data struct {
+					DB int `json:"db"`
+				} `json:"data"`
+			} `json:"payload"`
+		}
+		if err := conn.ReadJSON(&msg); err != nil {
+			t.Fatalf("read message: %v", err)
+		}
+		switch msg.Type {
+		case "data":
+			sessions[msg.ID] = msg.Payload.Data.DB
+		case "connection_ack", "ka":
+		default:
+			t.Fatalf("unexpected message %+v", msg)
+		}
+	}
+	// session 1 is attached by withDB to the upgrading request.
+	if sessions["1"] == 1 || sessions["2"] == 1 || sessions["1"] == sessions["2"] {
+		t.Errorf("operations should have their own db session, got %v", sessions)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 	"gitlab.com/abyss.club/uexky/uexky"
 )
 
@@ -31,6 +33,11 @@ func (s *Server) withUser(next http.Handler) http.Handler {
 		if token != nil {
 			var ctx context.Context
 			if token.User.IsGuest {
+				// guest tokens issued before the network is banned are rejected, like new ones in AuthHandler.
+				if err := s.Resolver.Uexky.CheckNetBan(r.Context(), realIP(r)); err != nil {
+					writeError(w, err)
+					return
+				}
 				ctx, err = s.Resolver.Uexky.AttachGuestUserToCtx(r.Context(), token.User.UserID)
 			} else {
 				ctx, err = s.Resolver.Uexky.AttachEmailUserToCtx(r.Context(), token.User.Email)
@@ -42,6 +49,7 @@ func (s *Server) withUser(next http.Handler) http.Handler {
 			r = r.WithContext(ctx)
 			http.SetCookie(w, token.Cookie())
 		}
+		r = r.WithContext(s.Resolver.Uexky.AttachClientIP(r.Context(), realIP(r)))
 
 		next.ServeHTTP(w, r)
 	})
@@ -50,14 +58,37 @@ func (s *Server) withUser(next http.Handler) http.Handler {
 func (s *Server) withDB(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := s.TxAdapter.AttachDB(r.Context())
+		ctx = s.Resolver.Uexky.AttachLoaders(ctx)
 		r = r.WithContext(ctx)
 		next.ServeHTTP(w, r)
 	})
 }
 
+// aroundOperations attaches a fresh db session and loaders to every graphql operation. Operations
+// over one websocket share the context of the upgrading request, so what withDB attached is not
+// enough: a transaction or loader of one operation would be seen by the others.
+// Query costs of the operation are charged once its response is resolved, the response is replaced
+// by the error if the client runs out of budget.
+func (s *Server) aroundOperations(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	ctx = s.TxAdapter.AttachDB(ctx)
+	ctx = s.Resolver.Uexky.AttachLoaders(ctx)
+	ctx = uexky.AttachOperation(ctx)
+	responses := next(ctx)
+	return func(ctx context.Context) *graphql.Response {
+		resp := responses(ctx)
+		if resp == nil {
+			return nil
+		}
+		if err := uexky.ChargeOperation(ctx); err != nil {
+			return &graphql.Response{Errors: gqlerror.List{presentError(ctx, err)}}
+		}
+		return resp
+	}
+}
+
 func (s *Server) withLimiter(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx := uexky.AttachLimiter(r.Context(), 50)
+		ctx := s.Limiter.AttachContext(r.Context(), clientIP(r), remoteIP(r))
 		r = r.WithContext(ctx)
 		next.ServeHTTP(w, r)
 	})
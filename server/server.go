@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -9,19 +10,32 @@ import (
 	"gitlab.com/abyss.club/uexky/adapter"
 	"gitlab.com/abyss.club/uexky/graph"
 	"gitlab.com/abyss.club/uexky/lib/config"
+	"gitlab.com/abyss.club/uexky/uexky"
 )
 
 type Server struct {
 	Resolver  *graph.Resolver
 	TxAdapter adapter.Tx
+	Limiter   *uexky.Limiter
 }
 
 func (s *Server) Run() error {
+	if err := config.CheckServing(); err != nil {
+		return err
+	}
 	srvCfg := config.Get().Server
 	addr := fmt.Sprintf("%s:%v", srvCfg.Host, srvCfg.Port)
+	if err := s.Resolver.Uexky.CheckSearch(context.Background()); err != nil {
+		return err
+	}
+	if err := s.Resolver.Uexky.WatchTags(context.Background()); err != nil {
+		return err
+	}
+	s.Resolver.Uexky.RunTrendingRefresher(context.Background())
+	s.Resolver.Uexky.RunTagPinyinIndexer(context.Background())
 	http.Handle("/", s.withDB(s.withUser(playground.Handler("GraphQL playground", "/graphql"))))
-	http.Handle("/graphql", s.withDB(s.withUser(s.withLimiter(s.GraphQLHandler()))))
-	http.Handle("/auth/", http.HandlerFunc(s.AuthHandler))
+	http.Handle("/graphql", s.withDB(s.withLimiter(s.withUser(s.GraphQLHandler()))))
+	http.Handle("/auth/", s.withDB(http.HandlerFunc(s.AuthHandler)))
 	log.Printf("connect to http://%s/ for GraphQL playground", addr)
 	return http.ListenAndServe(addr, nil)
 }
@@ -1,9 +1,12 @@
 package server
 
 import (
+	"net"
 	"net/http"
+	"strings"
 
 	log "github.com/sirupsen/logrus"
+	"gitlab.com/abyss.club/uexky/lib/config"
 	"gitlab.com/abyss.club/uexky/lib/errors"
 )
 
@@ -24,3 +27,39 @@ func writeError(w http.ResponseWriter, err error) {
 		log.Error(errors.Internal.Handle(err, "write http error"))
 	}
 }
+
+// clientIP read ip from http header in config, such as X-Real-IP or X-Forwarded-For.
+// Return empty string if the header is not configured.
+// Entries on the left of X-Forwarded-For are sent by client, only the ones appended by trusted proxies are used.
+func clientIP(r *http.Request) string {
+	cfg := config.Get().RateLimit
+	if cfg.HTTPHeader == "" {
+		return ""
+	}
+	ips := strings.Split(r.Header.Get(cfg.HTTPHeader), ",")
+	i := len(ips) - cfg.TrustedProxies
+	if cfg.TrustedProxies <= 0 {
+		i = len(ips) - 1
+	}
+	if i < 0 {
+		// the request passed fewer proxies, the left-most entry is added by the first of them.
+		i = 0
+	}
+	return strings.TrimSpace(ips[i])
+}
+
+// realIP returns ip from http header in config, or ip of remote address if the header is not configured.
+func realIP(r *http.Request) string {
+	if ip := clientIP(r); ip != "" {
+		return ip
+	}
+	return remoteIP(r)
+}
+
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"gitlab.com/abyss.club/uexky/lib/config"
+)
+
+func Test_clientIP(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  string
+		proxies int
+		value   string
+		want    string
+	}{
+		{name: "no header", header: "", proxies: 1, value: "1.1.1.1", want: ""},
+		{name: "real ip", header: "X-Real-IP", proxies: 1, value: "1.1.1.1", want: "1.1.1.1"},
+		{name: "one proxy", header: "X-Forwarded-For", proxies: 1, value: "6.6.6.6, 1.1.1.1", want: "1.1.1.1"},
+		{name: "two proxies", header: "X-Forwarded-For", proxies: 2, value: "6.6.6.6, 1.1.1.1, 2.2.2.2", want: "1.1.1.1"},
+		{name: "fewer entries", header: "X-Forwarded-For", proxies: 2, value: "1.1.1.1", want: "1.1.1.1"},
+	}
+	cfg := &config.Get().RateLimit
+	defer func(header string, proxies int) {
+		cfg.HTTPHeader, cfg.TrustedProxies = header, proxies
+	}(cfg.HTTPHeader, cfg.TrustedProxies)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg.HTTPHeader, cfg.TrustedProxies = tt.header, tt.proxies
+			r := httptest.NewRequest("GET", "/graphql", nil)
+			if tt.header != "" {
+				r.Header.Set(tt.header, tt.value)
+			}
+			if got := clientIP(r); got != tt.want {
+				t.Errorf("clientIP() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ func InitProdServer() (*Server, error) {
 	wire.Build(
 		wire.Struct(new(Server), "*"),
 		wire.Struct(new(graph.Resolver), "*"),
+		wire.Struct(new(uexky.Limiter), "*"),
 		uexky.ServiceSet,
 		auth.ServiceSet,
 		redis.NewClient,
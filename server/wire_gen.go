@@ -46,9 +46,13 @@ func InitProdServer() (*Server, error) {
 		Auth:  service,
 		Uexky: uexkyService,
 	}
+	limiter := &uexky.Limiter{
+		Redis: client,
+	}
 	server := &Server{
 		Resolver:  resolver,
 		TxAdapter: txAdapter,
+		Limiter:   limiter,
 	}
 	return server, nil
 }
@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"context"
+
+	"gitlab.com/abyss.club/uexky/lib/uid"
+)
+
+type BookmarkRepo interface {
+	Insert(ctx context.Context, userID uid.UID, item BookmarkItem) error
+	Delete(ctx context.Context, userID uid.UID, targetID uid.UID) error
+	IsBookmarked(ctx context.Context, userID uid.UID, targetID uid.UID) (bool, error)
+	// GetSlice returns bookmarked threads and posts of user, latest bookmarked first.
+	GetSlice(ctx context.Context, userID uid.UID, query SliceQuery) (*BookmarkSlice, error)
+}
+
+func (Thread) IsBookmarkItem() {}
+
+func (Post) IsBookmarkItem() {}
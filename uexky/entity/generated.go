@@ -11,11 +11,37 @@ import (
 	"gitlab.com/abyss.club/uexky/lib/uid"
 )
 
+//  Union type of bookmarked items.
+type BookmarkItem interface {
+	IsBookmarkItem()
+}
+
 //  Union type of Notificatiion contents
 type NotiContent interface {
 	IsNotiContent()
 }
 
+//  Union type of searching results.
+type SearchItem interface {
+	IsSearchItem()
+}
+
+//  BookmarkSlice object is for selecting specific 'slice' of bookmarked items.
+type BookmarkSlice struct {
+	Items     []BookmarkItem `json:"items"`
+	SliceInfo *SliceInfo     `json:"sliceInfo"`
+}
+
+type ModLogSlice struct {
+	Logs      []*ModLog  `json:"logs"`
+	SliceInfo *SliceInfo `json:"sliceInfo"`
+}
+
+type NetBanSlice struct {
+	Bans      []*NetBan  `json:"bans"`
+	SliceInfo *SliceInfo `json:"sliceInfo"`
+}
+
 //  NotiSlice object is for selecting specific 'slice' of an object to return.
 // Affects the returning SliceInfo.
 type NotiSlice struct {
@@ -76,6 +102,29 @@ type RepliedNoti struct {
 
 func (RepliedNoti) IsNotiContent() {}
 
+//  Actions taken on the reported content when resolving a report.
+type ReportAction struct {
+	//  Block the reported post, or the thread if a thread is reported.
+	Block *bool `json:"block"`
+	//  Lock the thread of reported content.
+	Lock *bool `json:"lock"`
+	//  Ban the author of reported content.
+	BanUser *bool `json:"banUser"`
+	//  Ban duration in hours, forever if it's not set.
+	BanDuration *int `json:"banDuration"`
+}
+
+type ReportSlice struct {
+	Reports   []*Report  `json:"reports"`
+	SliceInfo *SliceInfo `json:"sliceInfo"`
+}
+
+//  SearchSlice object is for selecting specific 'slice' of searching results.
+type SearchSlice struct {
+	Items     []SearchItem `json:"items"`
+	SliceInfo *SliceInfo   `json:"sliceInfo"`
+}
+
 //  SliceInfo objects are generated by the server.
 // Can be used in consecutive queries.
 type SliceInfo struct {
@@ -108,13 +157,6 @@ type SystemNoti struct {
 
 func (SystemNoti) IsNotiContent() {}
 
-type Tag struct {
-	//  Name of tag.
-	Name string `json:"name"`
-	//  The tag is a MainTag if true, SubTag otherwise.
-	IsMain bool `json:"isMain"`
-}
-
 //  The ID and timestamp of post replied in the thread.
 type ThreadCatalogItem struct {
 	//  The ID of post.
@@ -153,6 +195,79 @@ type ThreadSlice struct {
 	SliceInfo *SliceInfo `json:"sliceInfo"`
 }
 
+//  Object describing a change of current user's notifications.
+type UnreadNotiEvent struct {
+	//  The count of unread notifications.
+	UnreadCount int `json:"unreadCount"`
+	//  The notification created or updated.
+	Notification *Notification `json:"notification"`
+}
+
+type ModAction string
+
+const (
+	ModActionBlockPost     ModAction = "blockPost"
+	ModActionUnblockPost   ModAction = "unblockPost"
+	ModActionBlockThread   ModAction = "blockThread"
+	ModActionUnblockThread ModAction = "unblockThread"
+	ModActionLockThread    ModAction = "lockThread"
+	ModActionUnlockThread  ModAction = "unlockThread"
+	ModActionPinThread     ModAction = "pinThread"
+	ModActionUnpinThread   ModAction = "unpinThread"
+	ModActionEditTags      ModAction = "editTags"
+	ModActionBanUser       ModAction = "banUser"
+	ModActionUnbanUser     ModAction = "unbanUser"
+	ModActionBanNetwork    ModAction = "banNetwork"
+	ModActionUnbanNetwork  ModAction = "unbanNetwork"
+	ModActionSetUserRole   ModAction = "setUserRole"
+)
+
+var AllModAction = []ModAction{
+	ModActionBlockPost,
+	ModActionUnblockPost,
+	ModActionBlockThread,
+	ModActionUnblockThread,
+	ModActionLockThread,
+	ModActionUnlockThread,
+	ModActionPinThread,
+	ModActionUnpinThread,
+	ModActionEditTags,
+	ModActionBanUser,
+	ModActionUnbanUser,
+	ModActionBanNetwork,
+	ModActionUnbanNetwork,
+	ModActionSetUserRole,
+}
+
+func (e ModAction) IsValid() bool {
+	switch e {
+	case ModActionBlockPost, ModActionUnblockPost, ModActionBlockThread, ModActionUnblockThread, ModActionLockThread, ModActionUnlockThread, ModActionPinThread, ModActionUnpinThread, ModActionEditTags, ModActionBanUser, ModActionUnbanUser, ModActionBanNetwork, ModActionUnbanNetwork, ModActionSetUserRole:
+		return true
+	}
+	return false
+}
+
+func (e ModAction) String() string {
+	return string(e)
+}
+
+func (e *ModAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ModAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ModAction", str)
+	}
+	return nil
+}
+
+func (e ModAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type NotiType string
 
 const (
@@ -196,6 +311,101 @@ func (e NotiType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type ReportReason string
+
+const (
+	//  Advertisement or flooding.
+	ReportReasonSpam ReportReason = "spam"
+	//  Discrimination, hate or harassment.
+	ReportReasonAbuse ReportReason = "abuse"
+	//  Against the law or community rules.
+	ReportReasonIllegal ReportReason = "illegal"
+	//  Not related to the tags.
+	ReportReasonOffTopic ReportReason = "offTopic"
+	//  Explained in text.
+	ReportReasonOther ReportReason = "other"
+)
+
+var AllReportReason = []ReportReason{
+	ReportReasonSpam,
+	ReportReasonAbuse,
+	ReportReasonIllegal,
+	ReportReasonOffTopic,
+	ReportReasonOther,
+}
+
+func (e ReportReason) IsValid() bool {
+	switch e {
+	case ReportReasonSpam, ReportReasonAbuse, ReportReasonIllegal, ReportReasonOffTopic, ReportReasonOther:
+		return true
+	}
+	return false
+}
+
+func (e ReportReason) String() string {
+	return string(e)
+}
+
+func (e *ReportReason) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ReportReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ReportReason", str)
+	}
+	return nil
+}
+
+func (e ReportReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ReportStatus string
+
+const (
+	ReportStatusPending   ReportStatus = "pending"
+	ReportStatusResolved  ReportStatus = "resolved"
+	ReportStatusDismissed ReportStatus = "dismissed"
+)
+
+var AllReportStatus = []ReportStatus{
+	ReportStatusPending,
+	ReportStatusResolved,
+	ReportStatusDismissed,
+}
+
+func (e ReportStatus) IsValid() bool {
+	switch e {
+	case ReportStatusPending, ReportStatusResolved, ReportStatusDismissed:
+		return true
+	}
+	return false
+}
+
+func (e ReportStatus) String() string {
+	return string(e)
+}
+
+func (e *ReportStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ReportStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ReportStatus", str)
+	}
+	return nil
+}
+
+func (e ReportStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type Role string
 
 const (
@@ -247,3 +457,96 @@ func (e *Role) UnmarshalGQL(v interface{}) error {
 func (e Role) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+//  Sorting order of thread slice, all in descending order.
+type ThreadSort string
+
+const (
+	//  By the latest reply, default value.
+	ThreadSortBump ThreadSort = "bump"
+	//  By publishing time.
+	ThreadSortNewest ThreadSort = "newest"
+	//  By amount of replies.
+	ThreadSortReplies ThreadSort = "replies"
+	//  By the popularity decayed over time.
+	ThreadSortHot ThreadSort = "hot"
+)
+
+var AllThreadSort = []ThreadSort{
+	ThreadSortBump,
+	ThreadSortNewest,
+	ThreadSortReplies,
+	ThreadSortHot,
+}
+
+func (e ThreadSort) IsValid() bool {
+	switch e {
+	case ThreadSortBump, ThreadSortNewest, ThreadSortReplies, ThreadSortHot:
+		return true
+	}
+	return false
+}
+
+func (e ThreadSort) String() string {
+	return string(e)
+}
+
+func (e *ThreadSort) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ThreadSort(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ThreadSort", str)
+	}
+	return nil
+}
+
+func (e ThreadSort) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TrendingWindow string
+
+const (
+	//  Last 24 hours.
+	TrendingWindowDay TrendingWindow = "day"
+	//  Last 7 days.
+	TrendingWindowWeek TrendingWindow = "week"
+)
+
+var AllTrendingWindow = []TrendingWindow{
+	TrendingWindowDay,
+	TrendingWindowWeek,
+}
+
+func (e TrendingWindow) IsValid() bool {
+	switch e {
+	case TrendingWindowDay, TrendingWindowWeek:
+		return true
+	}
+	return false
+}
+
+func (e TrendingWindow) String() string {
+	return string(e)
+}
+
+func (e *TrendingWindow) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TrendingWindow(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TrendingWindow", str)
+	}
+	return nil
+}
+
+func (e TrendingWindow) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"gitlab.com/abyss.club/uexky/lib/errors"
+	"gitlab.com/abyss.club/uexky/lib/uid"
+)
+
+type ModLogRepo interface {
+	Insert(ctx context.Context, log *ModLog) error
+	GetSlice(ctx context.Context, query SliceQuery) (*ModLogSlice, error)
+}
+
+// ModLog is an append-only record of moderation action.
+type ModLog struct {
+	ID        uid.UID   `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	ActorID   uid.UID   `json:"-"`
+	Action    ModAction `json:"action"`
+	TargetID  uid.UID   `json:"targetId"`
+	Reason    *string   `json:"reason"`
+	Before    string    `json:"before"` // JSON encoded ModState of target
+	After     string    `json:"after"`
+}
+
+// NewModLog records the moderation action, actor of actions taken by command line tools has no id.
+func NewModLog(actor *User, action ModAction, targetID uid.UID, reason *string, before, after interface{}) (*ModLog, error) {
+	b, err := json.Marshal(before)
+	if err != nil {
+		return nil, errors.Internal.Handle(err, "marshal state before moderation")
+	}
+	a, err := json.Marshal(after)
+	if err != nil {
+		return nil, errors.Internal.Handle(err, "marshal state after moderation")
+	}
+	return &ModLog{
+		ID:        uid.NewUID(),
+		CreatedAt: time.Now(),
+		ActorID:   actor.ID,
+		Action:    action,
+		TargetID:  targetID,
+		Reason:    reason,
+		Before:    string(b),
+		After:     string(a),
+	}, nil
+}
+
+// ModState returns the part of thread changed by moderation actions.
+func (t *Thread) ModState() interface{} {
+	return struct {
+		MainTag     string     `json:"mainTag"`
+		SubTags     []string   `json:"subTags"`
+		Blocked     bool       `json:"blocked"`
+		Locked      bool       `json:"locked"`
+		Pinned      bool       `json:"pinned"`
+		PinnedUntil *time.Time `json:"pinnedUntil"`
+	}{t.MainTag, t.SubTags, t.Blocked, t.Locked, t.Pinned, t.PinnedUntil}
+}
+
+// ModState returns the part of post changed by moderation actions.
+func (p *Post) ModState() interface{} {
+	return struct {
+		Blocked bool `json:"blocked"`
+	}{p.Blocked}
+}
+
+// ModState returns the part of user changed by moderation actions.
+func (u *User) ModState() interface{} {
+	return struct {
+		Role         Role       `json:"role"`
+		BanExpiresAt *time.Time `json:"banExpiresAt"`
+		TagRoles     []TagRole  `json:"tagRoles"`
+	}{u.Role, u.BanExpiresAt, u.TagRoles}
+}
+
+// ModState returns the network ban created or deleted by moderation actions, the network itself is not included.
+func (b *NetBan) ModState() interface{} {
+	return struct {
+		TargetID  uid.UID    `json:"targetId"`
+		ExpiresAt *time.Time `json:"expiresAt"`
+	}{b.TargetID, b.ExpiresAt}
+}
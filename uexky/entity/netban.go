@@ -0,0 +1,82 @@
+package entity
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"gitlab.com/abyss.club/uexky/lib/errors"
+	"gitlab.com/abyss.club/uexky/lib/uid"
+)
+
+type NetBanRepo interface {
+	Insert(ctx context.Context, ban *NetBan) error
+	GetByID(ctx context.Context, id uid.UID) (*NetBan, error)
+	Delete(ctx context.Context, id uid.UID) error
+	// Match returns the unexpired ban covering the ip, nil if there is none.
+	Match(ctx context.Context, ip string) (*NetBan, error)
+	GetSlice(ctx context.Context, query SliceQuery) (*NetBanSlice, error)
+}
+
+// NetBan stops a network from signing in as guest and publishing. The network is never exposed,
+// moderators refer to it by the thread or post it is created from.
+// Guests are not banned by token fingerprint: a guest drops it with the cookie and signs in again,
+// the network is what stays. A guest token in use is banned by BanUser.
+type NetBan struct {
+	ID        uid.UID    `json:"id"`
+	CreatedAt time.Time  `json:"createdAt"`
+	Network   string     `json:"-"` // in CIDR notation
+	TargetID  uid.UID    `json:"targetId"`
+	CreatorID uid.UID    `json:"-"`
+	Reason    *string    `json:"reason"`
+	ExpiresAt *time.Time `json:"expiresAt"` // nil if banned forever
+}
+
+// default prefix length of banned network
+const (
+	DefaultIPv4Prefix = 32
+	DefaultIPv6Prefix = 64
+)
+
+// NewNetBan bans the network of author, prefix is the length of network prefix.
+func NewNetBan(
+	creator *User, author *Author, targetID uid.UID, prefix *int, reason *string, expiresAt *time.Time,
+) (*NetBan, error) {
+	ip := net.ParseIP(author.IP)
+	if ip == nil {
+		return nil, errors.BadParams.New("no ip recorded for the content")
+	}
+	bits, ones := 128, DefaultIPv6Prefix
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+		bits, ones = 32, DefaultIPv4Prefix
+	}
+	if prefix != nil {
+		ones = *prefix
+	}
+	if ones < bits/4 || ones > bits { // at least /8 for IPv4 and /32 for IPv6
+		return nil, errors.BadParams.Errorf("invalid network prefix length %v", ones)
+	}
+	if expiresAt != nil && expiresAt.Before(time.Now()) {
+		return nil, errors.BadParams.New("ban expires at a past time")
+	}
+	mask := net.CIDRMask(ones, bits)
+	network := &net.IPNet{IP: ip.Mask(mask), Mask: mask}
+	return &NetBan{
+		ID:        uid.NewUID(),
+		CreatedAt: time.Now(),
+		Network:   network.String(),
+		TargetID:  targetID,
+		CreatorID: creator.ID,
+		Reason:    reason,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// PermissionError is returned to clients in the banned network, carries the reason and expiry like banned users.
+func (b *NetBan) PermissionError() error {
+	return errors.Permission.With(errors.Extensions{
+		"banReason":    b.Reason,
+		"banExpiresAt": b.ExpiresAt,
+	}).New("permission denied, network is banned")
+}
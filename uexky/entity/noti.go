@@ -20,11 +20,16 @@ type NotiService struct {
 type NotiRepo interface {
 	GetUnreadCount(ctx context.Context, user *User) (int, error)
 	GetByKey(ctx context.Context, userID uid.UID, key string) (*Notification, error)
+	LockByKey(ctx context.Context, userID uid.UID, key string) (*Notification, error)
 	GetSlice(ctx context.Context, user *User, query SliceQuery) (*NotiSlice, error)
 	Insert(ctx context.Context, notification *Notification) error
+	InsertIfAbsent(ctx context.Context, notification *Notification) (bool, error)
 
 	UpdateContent(ctx context.Context, noti *Notification) error
 	UpdateReadID(ctx context.Context, user *User, id uid.UID) error
+
+	// Subscribe returns keys of notifications inserted or updated for the user.
+	Subscribe(ctx context.Context, user *User) (<-chan string, error)
 }
 
 type Notification struct {
@@ -77,20 +82,22 @@ func NewSystemNoti(title, content string, receivers ...Receiver) (*Notification,
 	return noti, nil
 }
 
-func RepliedNotiKey(thread *Thread) string {
-	return fmt.Sprintf("replied:%s", thread.ID.ToBase64String())
+// RepliedNotiKey is the key of replied notification of the thread for a watcher,
+// replies are merged into it until the watcher reads it.
+func RepliedNotiKey(thread *Thread, receiver uid.UID) string {
+	if receiver == thread.Author.UserID {
+		return fmt.Sprintf("replied:%s", thread.ID.ToBase64String())
+	}
+	return fmt.Sprintf("replied:%s:%s", thread.ID.ToBase64String(), receiver.ToBase64String())
 }
 
-func NewRepliedNoti(user *User, thread *Thread, reply *Post) *Notification {
-	if thread.Author.Guest {
-		return nil
-	}
+func NewRepliedNoti(receiver uid.UID, thread *Thread, reply *Post) *Notification {
 	noti := &Notification{
 		Type:      NotiTypeReplied,
-		Key:       RepliedNotiKey(thread),
+		Key:       RepliedNotiKey(thread, receiver),
 		SortKey:   uid.NewUID(),
 		EventTime: time.Now(),
-		Receivers: []Receiver{SendToUser(thread.Author.UserID)},
+		Receivers: []Receiver{SendToUser(receiver)},
 		Content: RepliedNoti{
 			Thread: &ThreadOutline{
 				ID:      thread.ID,
@@ -180,7 +187,7 @@ func (n *Notification) EncodeContent() (map[string]interface{}, error) {
 	return m, nil
 }
 
-func (n *Notification) AddReply(user *User, thread *Thread, reply *Post) {
+func (n *Notification) AddReply(thread *Thread, reply *Post) {
 	if n.Type != NotiTypeReplied {
 		panic("AddReply only support Replied Notification")
 	}
@@ -225,3 +232,19 @@ const (
 func NewWelcomeNoti(user *User) (*Notification, error) {
 	return NewSystemNoti(WelcomeTitle, WelcomeContent, SendToUser(user.ID))
 }
+
+const BanTitle = "封禁通知"
+
+// NewBanNoti tells the banned user why and until when.
+func NewBanNoti(user *User) (*Notification, error) {
+	reason := "无"
+	if user.BanReason != nil {
+		reason = *user.BanReason
+	}
+	expiresAt := "永久"
+	if user.BanExpiresAt != nil {
+		expiresAt = user.BanExpiresAt.Format("2006-01-02 15:04 MST")
+	}
+	content := fmt.Sprintf("你的帐号已被管理员封禁，封禁期间无法发帖和回复。\n\n- 原因：%s\n- 解封时间：%s", reason, expiresAt)
+	return NewSystemNoti(BanTitle, content, SendToUser(user.ID))
+}
@@ -12,29 +12,37 @@ import (
 type PostRepo interface {
 	CheckIfDuplicated(ctx context.Context, userID uid.UID, content string) error
 	GetByID(ctx context.Context, id uid.UID) (*Post, error)
+	GetByIDs(ctx context.Context, ids []uid.UID) ([]*Post, error)
 
 	Insert(ctx context.Context, post *Post) (*Post, error)
 	Update(ctx context.Context, post *Post) (*Post, error)
+	// UpdateContent saves the edited content, Update never touches it.
+	UpdateContent(ctx context.Context, post *Post) (*Post, error)
 
 	QuotedPosts(ctx context.Context, post *Post) ([]*Post, error)
 	QuotedCount(ctx context.Context, post *Post) (int, error)
+	QuotedCounts(ctx context.Context, ids []uid.UID) (map[uid.UID]int, error)
+
+	PublishNewPost(ctx context.Context, post *Post) error
+	SubscribeNewPosts(ctx context.Context, threadID uid.UID) (<-chan uid.UID, error)
 }
 
 type Post struct {
-	ID        uid.UID   `json:"id"`
-	ThreadID  uid.UID   `json:"-"`
-	CreatedAt time.Time `json:"createdAt"`
-	Author    *Author   `json:"author"`
-	QuoteIDs  []uid.UID `json:"-"`
-	Content   string    `json:"content"`
-	Blocked   bool      `json:"blocked"`
+	ID        uid.UID    `json:"id"`
+	ThreadID  uid.UID    `json:"-"`
+	CreatedAt time.Time  `json:"createdAt"`
+	Author    *Author    `json:"author"`
+	QuoteIDs  []uid.UID  `json:"-"`
+	Content   string     `json:"content"`
+	Blocked   bool       `json:"blocked"`
+	EditedAt  *time.Time `json:"editedAt"`
 }
 
 func (p Post) String() string {
 	return fmt.Sprintf("<Post:%v:%s>", p.ID, p.ID.ToBase64String())
 }
 
-func NewPost(input *PostInput, user *User, thread *Thread, aid string) (*Post, error) {
+func NewPost(input *PostInput, user *User, thread *Thread) (*Post, error) {
 	if thread.Locked {
 		return nil, errors.BadParams.New("thread has been locked")
 	}
@@ -46,12 +54,14 @@ func NewPost(input *PostInput, user *User, thread *Thread, aid string) (*Post, e
 			UserID:    user.ID,
 			Guest:     user.Role == RoleGuest,
 			Anonymous: input.Anonymous,
+			// the OP replying in another identity should not be revealed.
+			IsOP: user.ID == thread.Author.UserID && input.Anonymous == thread.Author.Anonymous,
 		},
 		QuoteIDs: input.QuoteIds,
 		Content:  input.Content,
 	}
 	if input.Anonymous {
-		post.Author.Author = aid
+		post.Author.Author = thread.AnonymousID(user)
 	} else {
 		if user.Name == nil {
 			return nil, errors.BadParams.New("user name must be set")
@@ -61,6 +71,22 @@ func NewPost(input *PostInput, user *User, thread *Thread, aid string) (*Post, e
 	return post, nil
 }
 
+// Edit changes content of post in the thread, returns the revision of previous version.
+func (p *Post) Edit(user *User, thread *Thread, content string) (*Revision, error) {
+	if err := checkEditable(user, p.Author, p.CreatedAt, p.Blocked, thread.Locked); err != nil {
+		return nil, err
+	}
+	rev := newRevision(p.ID, p.CreatedAt, p.EditedAt, nil, p.Content)
+	now := time.Now()
+	p.Content = content
+	p.EditedAt = &now
+	return rev, nil
+}
+
 func (p *Post) Block() {
 	p.Blocked = true
 }
+
+func (p *Post) Unblock() {
+	p.Blocked = false
+}
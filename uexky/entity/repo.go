@@ -1,9 +1,15 @@
 package entity
 
 type Repo struct {
-	User   UserRepo
-	Thread ThreadRepo
-	Post   PostRepo
-	Tag    TagRepo
-	Noti   NotiRepo
+	User     UserRepo
+	Thread   ThreadRepo
+	Post     PostRepo
+	Tag      TagRepo
+	Noti     NotiRepo
+	Search   SearchRepo
+	Revision RevisionRepo
+	Bookmark BookmarkRepo
+	Report   ReportRepo
+	ModLog   ModLogRepo
+	NetBan   NetBanRepo
 }
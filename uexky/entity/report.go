@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"gitlab.com/abyss.club/uexky/lib/errors"
+	"gitlab.com/abyss.club/uexky/lib/uid"
+)
+
+const ReportTextMaxLength = 500
+
+type ReportRepo interface {
+	// Insert returns Duplicated error if the user has reported the target.
+	Insert(ctx context.Context, report *Report) error
+	GetByID(ctx context.Context, id uid.UID) (*Report, error)
+	Update(ctx context.Context, report *Report) (*Report, error)
+	GetSlice(ctx context.Context, status ReportStatus, query SliceQuery) (*ReportSlice, error)
+}
+
+// Report is a thread or post reported by user, waiting for moderators to handle.
+type Report struct {
+	ID         uid.UID      `json:"id"`
+	CreatedAt  time.Time    `json:"createdAt"`
+	ReporterID uid.UID      `json:"-"`
+	ThreadID   uid.UID      `json:"-"`
+	PostID     *uid.UID     `json:"-"` // nil if the thread itself is reported
+	Reason     ReportReason `json:"reason"`
+	Text       *string      `json:"text"`
+	Status     ReportStatus `json:"status"`
+	HandlerID  *uid.UID     `json:"-"`
+	HandledAt  *time.Time   `json:"handledAt"`
+}
+
+func newReport(user *User, threadID uid.UID, postID *uid.UID, reason ReportReason, text *string) (*Report, error) {
+	if !reason.IsValid() {
+		return nil, errors.BadParams.Errorf("invalid reason '%s'", reason)
+	}
+	if text != nil && utf8.RuneCountInString(*text) > ReportTextMaxLength {
+		return nil, errors.BadParams.Errorf("text of report is longer than %v", ReportTextMaxLength)
+	}
+	return &Report{
+		ID:         uid.NewUID(),
+		CreatedAt:  time.Now(),
+		ReporterID: user.ID,
+		ThreadID:   threadID,
+		PostID:     postID,
+		Reason:     reason,
+		Text:       text,
+		Status:     ReportStatusPending,
+	}, nil
+}
+
+func NewThreadReport(user *User, thread *Thread, reason ReportReason, text *string) (*Report, error) {
+	return newReport(user, thread.ID, nil, reason, text)
+}
+
+func NewPostReport(user *User, post *Post, reason ReportReason, text *string) (*Report, error) {
+	return newReport(user, post.ThreadID, &post.ID, reason, text)
+}
+
+// Handle closes the report with status resolved or dismissed.
+func (r *Report) Handle(handler *User, status ReportStatus) error {
+	if r.Status != ReportStatusPending {
+		return errors.BadParams.Errorf("report has been %s", r.Status)
+	}
+	if status == ReportStatusPending || !status.IsValid() {
+		return errors.BadParams.Errorf("can not handle report to '%s'", status)
+	}
+	now := time.Now()
+	r.Status = status
+	r.HandlerID = &handler.ID
+	r.HandledAt = &now
+	return nil
+}
@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"context"
+	"time"
+
+	"gitlab.com/abyss.club/uexky/lib/config"
+	"gitlab.com/abyss.club/uexky/lib/errors"
+	"gitlab.com/abyss.club/uexky/lib/uid"
+)
+
+type RevisionRepo interface {
+	Insert(ctx context.Context, revision *Revision) error
+	FindByTarget(ctx context.Context, targetID uid.UID) ([]*Revision, error)
+}
+
+// Revision is a previous version of thread or post.
+type Revision struct {
+	ID        uid.UID   `json:"-"`
+	TargetID  uid.UID   `json:"-"`
+	CreatedAt time.Time `json:"createdAt"`
+	Title     *string   `json:"title"`
+	Content   string    `json:"content"`
+}
+
+func newRevision(targetID uid.UID, createdAt time.Time, editedAt *time.Time, title *string, content string) *Revision {
+	rev := &Revision{
+		ID:        uid.NewUID(),
+		TargetID:  targetID,
+		CreatedAt: createdAt,
+		Title:     title,
+		Content:   content,
+	}
+	if editedAt != nil {
+		rev.CreatedAt = *editedAt
+	}
+	return rev
+}
+
+// checkEditable checks edit of content in the thread, locked is whether the thread is locked.
+func checkEditable(user *User, author *Author, createdAt time.Time, blocked, locked bool) error {
+	if user.ID != author.UserID {
+		return errors.Permission.New("only author can edit")
+	}
+	if blocked {
+		return errors.BadParams.New("blocked content can not be edited")
+	}
+	if locked {
+		return errors.BadParams.New("thread has been locked")
+	}
+	window := time.Duration(config.Get().EditWindow) * time.Second
+	if time.Since(createdAt) > window {
+		return errors.BadParams.Errorf("can only edit in %v after publishing", window)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"gitlab.com/abyss.club/uexky/lib/errors"
+	"gitlab.com/abyss.club/uexky/lib/uid"
+)
+
+const (
+	SearchTextMaxLength = 64
+	SearchMaxTerms      = 8
+)
+
+type ContentSearch struct {
+	Text  string
+	Terms []string
+	Tags  []string
+	// threads with ExcludedTags or hidden by user HiddenBy, and posts in them, are left out.
+	ExcludedTags []string
+	HiddenBy     *uid.UID
+}
+
+type SearchRepo interface {
+	Search(ctx context.Context, search *ContentSearch, query SliceQuery) (*SearchSlice, error)
+	CheckCJK(ctx context.Context) error
+}
+
+// NewContentSearch splits text to terms by spaces, content should contain all the terms.
+func NewContentSearch(text string, tags []string) (*ContentSearch, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, errors.BadParams.New("search text must be specified")
+	}
+	if utf8.RuneCountInString(text) > SearchTextMaxLength {
+		return nil, errors.BadParams.Errorf("search text is longer than %v", SearchTextMaxLength)
+	}
+	terms := strings.Fields(text)
+	if len(terms) > SearchMaxTerms {
+		return nil, errors.BadParams.Errorf("search text has more than %v words", SearchMaxTerms)
+	}
+	return &ContentSearch{Text: text, Terms: terms, Tags: tags}, nil
+}
+
+func (Thread) IsSearchItem() {}
+
+func (Post) IsSearchItem() {}
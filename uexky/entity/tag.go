@@ -1,6 +1,36 @@
 package entity
 
-import "context"
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gitlab.com/abyss.club/uexky/lib/errors"
+)
+
+const (
+	MainTagMaxLength        = 16
+	TagDescriptionMaxLength = 200
+	TagRulesMaxLength       = 5000
+)
+
+const (
+	// TrendingTagsLimit is the count of trending tags kept in each window.
+	TrendingTagsLimit = 100
+	// RecommendedTagsCount is the count of tags recommended to a user.
+	RecommendedTagsCount = 10
+)
+
+type Tag struct {
+	Name            string     `json:"name"`
+	IsMain          bool       `json:"isMain"`
+	Description     *string    `json:"description"`
+	Rules           *string    `json:"rules"`
+	ThreadCount     int        `json:"threadCount"`
+	SubscriberCount int        `json:"subscriberCount"`
+	ActiveAt        *time.Time `json:"activeAt"`
+}
 
 type TagSearch struct {
 	Text  string
@@ -10,6 +40,62 @@ type TagSearch struct {
 type TagRepo interface {
 	SetMainTags(ctx context.Context, mainTags []string) error
 	GetMainTags(ctx context.Context) ([]string, error)
+	AddMainTag(ctx context.Context, name string) error
+	RenameMainTag(ctx context.Context, name, newName string) error
+	RetireMainTag(ctx context.Context, name string) error
+	ReorderMainTags(ctx context.Context, tags []string) error
+
+	GetAliases(ctx context.Context) (map[string]string, error)
+	MergeTags(ctx context.Context, from, into string) error
 
+	// main tags and aliases are cached in config of every instance, which reloads them when notified.
+	PublishTagsChanged(ctx context.Context)
+	SubscribeTagsChanged(ctx context.Context) (<-chan struct{}, error)
+
+	GetByName(ctx context.Context, name string) (*Tag, error)
+	// UpdateInfo sets description and rules of main tag, including retired ones.
+	UpdateInfo(ctx context.Context, name string, description, rules *string) error
 	Search(ctx context.Context, search *TagSearch) ([]*Tag, error)
+	IndexPinyin(ctx context.Context) (int, error)
+
+	// RefreshTrending computes trending tags in window and the co-occurrence of them with other tags.
+	RefreshTrending(ctx context.Context, window TrendingWindow) error
+	GetTrending(ctx context.Context, window TrendingWindow, limit int) ([]TagScore, error)
+	// GetCooccurrence returns the sum of co-occurrence of each candidate with tags.
+	GetCooccurrence(ctx context.Context, window TrendingWindow, tags, candidates []string) (map[string]float64, error)
+	LockTrendingRefresh(ctx context.Context, ttl time.Duration) (bool, error)
+}
+
+// TagScore is the activity of a tag in recent threads, by new threads and replies.
+type TagScore struct {
+	Name  string
+	Score float64
+}
+
+func (w TrendingWindow) Duration() time.Duration {
+	if w == TrendingWindowWeek {
+		return 7 * 24 * time.Hour
+	}
+	return 24 * time.Hour
+}
+
+// ValidateMainTag checks name of a new main tag.
+func ValidateMainTag(name string) error {
+	if name == "" || strings.TrimSpace(name) != name {
+		return errors.BadParams.New("main tag must not be empty or surrounded by spaces")
+	}
+	if utf8.RuneCountInString(name) > MainTagMaxLength {
+		return errors.BadParams.Errorf("main tag is longer than %v", MainTagMaxLength)
+	}
+	return nil
+}
+
+func ValidateTagInfo(description, rules *string) error {
+	if description != nil && utf8.RuneCountInString(*description) > TagDescriptionMaxLength {
+		return errors.BadParams.Errorf("description is longer than %v", TagDescriptionMaxLength)
+	}
+	if rules != nil && utf8.RuneCountInString(*rules) > TagRulesMaxLength {
+		return errors.BadParams.Errorf("rules is longer than %v", TagRulesMaxLength)
+	}
+	return nil
 }
@@ -2,6 +2,9 @@ package entity
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
 	"time"
 
@@ -13,6 +16,14 @@ import (
 type ThreadsSearch struct {
 	UserID *uid.UID
 	Tags   []string
+	Sort   ThreadSort
+
+	// SkipPinned treats pinned threads as normal ones, instead of putting them on the first page.
+	SkipPinned  bool
+	SkipBlocked bool
+	// ExcludedTags and threads hidden by user HiddenBy are left out.
+	ExcludedTags []string
+	HiddenBy     *uid.UID
 }
 
 type ThreadRepo interface {
@@ -22,11 +33,24 @@ type ThreadRepo interface {
 
 	Insert(ctx context.Context, thread *Thread) (*Thread, error)
 	Update(ctx context.Context, thread *Thread) (*Thread, error)
+	// UpdateContent saves the edited title and content, Update never touches them.
+	UpdateContent(ctx context.Context, thread *Thread) (*Thread, error)
 
 	Replies(ctx context.Context, thread *Thread, query SliceQuery) (*PostSlice, error)
 	ReplyCount(ctx context.Context, thread *Thread) (int, error)
+	ReplyCounts(ctx context.Context, ids []uid.UID) (map[uid.UID]int, error)
 	Catalog(ctx context.Context, thread *Thread) ([]*ThreadCatalogItem, error)
-	PostAID(ctx context.Context, thread *Thread, user *User) (string, error)
+
+	// Watchers of thread receive replied notifications.
+	Watch(ctx context.Context, threadID uid.UID, userID uid.UID) error
+	Unwatch(ctx context.Context, threadID uid.UID, userID uid.UID) error
+	IsWatched(ctx context.Context, threadID uid.UID, userID uid.UID) (bool, error)
+	Watchers(ctx context.Context, threadID uid.UID) ([]uid.UID, error)
+
+	// Hidden threads are left out from thread slices, timeline and search of the user.
+	Hide(ctx context.Context, threadID uid.UID, userID uid.UID) error
+	Unhide(ctx context.Context, threadID uid.UID, userID uid.UID) error
+	HiddenSlice(ctx context.Context, userID uid.UID, query SliceQuery) (*ThreadSlice, error)
 }
 
 type Thread struct {
@@ -40,6 +64,10 @@ type Thread struct {
 	SubTags    []string  `json:"sub_tags"`
 	Blocked    bool      `json:"blocked"`
 	Locked     bool      `json:"locked"`
+
+	Pinned      bool       `json:"pinned"`
+	PinnedUntil *time.Time `json:"pinnedUntil"`
+	EditedAt    *time.Time `json:"editedAt"`
 }
 
 const (
@@ -52,6 +80,9 @@ type Author struct {
 	Guest     bool    `json:"-"`
 	Anonymous bool    `json:"anonymous"`
 	Author    string  `json:"author"`
+	IsOP      bool    `json:"isOP"`
+	// IP of client when published, only for network bans.
+	IP string `json:"-"`
 }
 
 func NewThread(user *User, input ThreadInput) (*Thread, error) {
@@ -62,6 +93,7 @@ func NewThread(user *User, input ThreadInput) (*Thread, error) {
 			UserID:    user.ID,
 			Guest:     user.Role == RoleGuest,
 			Anonymous: input.Anonymous,
+			IsOP:      true,
 		},
 		Title:   input.Title,
 		Content: input.Content,
@@ -69,7 +101,7 @@ func NewThread(user *User, input ThreadInput) (*Thread, error) {
 		SubTags: input.SubTags,
 	}
 	if input.Anonymous {
-		thread.Author.Author = uid.NewUID().ToBase64String()
+		thread.Author.Author = anonymousID(thread.ID, user.ID)
 	} else {
 		if user.Name == nil {
 			return nil, errors.BadParams.New("user name must be set")
@@ -84,8 +116,25 @@ func NewThread(user *User, input ThreadInput) (*Thread, error) {
 	return thread, nil
 }
 
+// AnonymousID returns the anonymous id of user in the thread, it's stable for the same user and thread.
+func (t *Thread) AnonymousID(user *User) string {
+	if t.Author.Anonymous && t.Author.UserID == user.ID {
+		return t.Author.Author
+	}
+	return anonymousID(t.ID, user.ID)
+}
+
+// anonymousID is derived from server secret, so it can't be used to trace the user.
+func anonymousID(threadID, userID uid.UID) string {
+	mac := hmac.New(sha256.New, []byte(config.Get().AnonymousIDSecret))
+	fmt.Fprintf(mac, "%v:%v", threadID, userID)
+	sum := binary.BigEndian.Uint64(mac.Sum(nil))
+	// keep in 60 bits like uid, and fix the highest bit to get the same length.
+	return uid.UID(sum&(1<<60-1) | 1<<59).ToBase64String()
+}
+
 func validateThreadTags(mainTag string, subTags []string) ([]string, error) {
-	mains, subs := config.SplitTags(append(subTags, mainTag)...)
+	mains, subs := config.SplitTags(append(config.CanonicalTags(subTags), mainTag)...)
 	if len(mains) != 1 {
 		return nil, errors.BadParams.New("must specify only one main tag")
 	}
@@ -109,10 +158,47 @@ func (t *Thread) EditTags(mainTag string, subTags []string) error {
 	return nil
 }
 
+// Edit changes title and content of thread, returns the revision of previous version.
+func (t *Thread) Edit(user *User, title *string, content string) (*Revision, error) {
+	if err := checkEditable(user, t.Author, t.CreatedAt, t.Blocked, t.Locked); err != nil {
+		return nil, err
+	}
+	rev := newRevision(t.ID, t.CreatedAt, t.EditedAt, t.Title, t.Content)
+	now := time.Now()
+	t.Title = title
+	t.Content = content
+	t.EditedAt = &now
+	return rev, nil
+}
+
 func (t *Thread) Lock() {
 	t.Locked = true
 }
 
+// Reopen unlocks thread. It's not named Unlock, which makes Thread a sync.Locker.
+func (t *Thread) Reopen() {
+	t.Locked = false
+}
+
 func (t *Thread) Block() {
 	t.Blocked = true
 }
+
+func (t *Thread) Unblock() {
+	t.Blocked = false
+}
+
+// Pin pins thread at the top of its main tag until the time, or forever if until is nil.
+func (t *Thread) Pin(until *time.Time) error {
+	if until != nil && until.Before(time.Now()) {
+		return errors.BadParams.New("pinned until a past time")
+	}
+	t.Pinned = true
+	t.PinnedUntil = until
+	return nil
+}
+
+func (t *Thread) Unpin() {
+	t.Pinned = false
+	t.PinnedUntil = nil
+}
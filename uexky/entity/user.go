@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"gitlab.com/abyss.club/uexky/lib/algo"
+	"gitlab.com/abyss.club/uexky/lib/config"
 	"gitlab.com/abyss.club/uexky/lib/errors"
 	"gitlab.com/abyss.club/uexky/lib/uid"
 )
@@ -14,6 +15,8 @@ type UserRepo interface {
 	GetByEmail(ctx context.Context, email string) (*User, error)
 	GetByID(ctx context.Context, id uid.UID) (*User, error)
 	GetGuestByID(ctx context.Context, id uid.UID) (*User, error)
+	// GetStaff returns admins and moderators, including moderators of main tags.
+	GetStaff(ctx context.Context) ([]*User, error)
 
 	// Write
 	Insert(ctx context.Context, user *User) (*User, error)
@@ -31,15 +34,32 @@ type User struct {
 	Role         Role     `json:"role"`
 	Tags         []string `json:"tags"`
 	LastReadNoti uid.UID  `json:"-"`
+	// AutoWatch makes user watch the threads they reply to.
+	AutoWatch bool `json:"autoWatch"`
+	// PrevRole is the role before user is banned, restored by Unban.
+	PrevRole     *Role      `json:"prevRole"`
+	BanReason    *string    `json:"banReason"`
+	BanExpiresAt *time.Time `json:"banExpiresAt"` // nil if banned forever
+	// TagRoles are roles granted in threads of main tags, such as moderators of one main tag.
+	TagRoles []TagRole `json:"tagRoles"`
+	// MutedTags are excluded from thread slices, timeline and search of the user.
+	MutedTags []string `json:"mutedTags"`
+}
+
+// TagRole grants the role to user in threads of the main tag.
+type TagRole struct {
+	Tag  string `json:"tag"`
+	Role Role   `json:"role"`
 }
 
 const GuestExpireTime = 30 * time.Hour * 24
 
 func NewSignedInUser(email string) *User {
 	return &User{
-		ID:    uid.NewUID(),
-		Email: &email,
-		Role:  RoleNormal,
+		ID:        uid.NewUID(),
+		Email:     &email,
+		Role:      RoleNormal,
+		AutoWatch: true,
 	}
 }
 
@@ -50,8 +70,77 @@ func NewGuestUser(id uid.UID) *User {
 	}
 }
 
-func (u *User) Ban() {
+// Ban bans user until expiresAt, or forever if it's nil. Banning a banned user changes the reason and expiry.
+func (u *User) Ban(reason *string, expiresAt *time.Time) error {
+	if expiresAt != nil && expiresAt.Before(time.Now()) {
+		return errors.BadParams.New("ban expires at a past time")
+	}
+	if u.Role != RoleBanned {
+		prev := u.Role
+		u.PrevRole = &prev
+	}
 	u.Role = RoleBanned
+	u.BanReason = reason
+	u.BanExpiresAt = expiresAt
+	return nil
+}
+
+// BanExpired reports whether user is banned and the ban has expired.
+func (u *User) BanExpired() bool {
+	return u.Role == RoleBanned && u.BanExpiresAt != nil && !u.BanExpiresAt.After(time.Now())
+}
+
+// Unban restores the role before user is banned.
+func (u *User) Unban() error {
+	if u.Role != RoleBanned {
+		return errors.BadParams.New("user is not banned")
+	}
+	u.Role = RoleNormal
+	if u.PrevRole != nil {
+		u.Role = *u.PrevRole
+	}
+	u.PrevRole = nil
+	u.BanReason = nil
+	u.BanExpiresAt = nil
+	return nil
+}
+
+// NewCLIUser returns the administrator using command line tools, who has no id.
+func NewCLIUser() *User {
+	return &User{Role: RoleAdmin}
+}
+
+// SetRole changes role of user, or the role granted in the main tag if tag is not empty.
+// Setting role to normal in main tag revokes the granted role.
+func (u *User) SetRole(role Role, tag string) error {
+	if u.Email == nil {
+		return errors.BadParams.New("can not set role of guest")
+	}
+	if u.Role == RoleBanned {
+		return errors.BadParams.New("user is banned")
+	}
+	switch {
+	case tag == "" && (role == RoleAdmin || role == RoleMod || role == RoleNormal):
+		u.Role = role
+		return nil
+	case tag != "" && (role == RoleMod || role == RoleNormal):
+	default:
+		return errors.BadParams.Errorf("can not set role to %s", role)
+	}
+	if !algo.InStrSlice(config.GetMainTags(), tag) {
+		return errors.BadParams.Errorf("%s is not a main tag", tag)
+	}
+	tagRoles := []TagRole{}
+	for _, tr := range u.TagRoles {
+		if tr.Tag != tag {
+			tagRoles = append(tagRoles, tr)
+		}
+	}
+	if role != RoleNormal {
+		tagRoles = append(tagRoles, TagRole{Tag: tag, Role: role})
+	}
+	u.TagRoles = tagRoles
+	return nil
 }
 
 func (u *User) SetName(name string) error {
@@ -63,18 +152,30 @@ func (u *User) SetName(name string) error {
 }
 
 func (u *User) SetTags(tags []string) {
+	u.Tags = canonicalTags(tags)
+}
+
+// CanonicalizeTags applies tag aliases to subscribed and muted tags,
+// for users whose tags are not rewritten when tags are merged, such as guests.
+func (u *User) CanonicalizeTags() {
+	u.Tags = canonicalTags(u.Tags)
+	u.MutedTags = canonicalTags(u.MutedTags)
+}
+
+func canonicalTags(tags []string) []string {
 	repeat := map[string]bool{}
 	var ts []string
-	for _, tag := range tags {
+	for _, tag := range config.CanonicalTags(tags) {
 		if !repeat[tag] {
 			ts = append(ts, tag)
 		}
 		repeat[tag] = true
 	}
-	u.Tags = ts
+	return ts
 }
 
 func (u *User) AddTag(tag string) {
+	tag = config.CanonicalTag(tag)
 	for _, t := range u.Tags {
 		if t == tag {
 			return
@@ -93,6 +194,42 @@ func (u *User) DelTag(tag string) {
 	u.Tags = tags
 }
 
+const MutedTagsMaxCount = 50
+
+func (u *User) MuteTag(tag string) error {
+	tag = config.CanonicalTag(tag)
+	if algo.InStrSlice(u.MutedTags, tag) {
+		return nil
+	}
+	if len(u.MutedTags) >= MutedTagsMaxCount {
+		return errors.BadParams.Errorf("can not mute more than %v tags", MutedTagsMaxCount)
+	}
+	u.MutedTags = append(u.MutedTags, tag)
+	return nil
+}
+
+func (u *User) UnmuteTag(tag string) {
+	tag = config.CanonicalTag(tag)
+	var tags []string
+	for _, t := range u.MutedTags {
+		if t != tag {
+			tags = append(tags, t)
+		}
+	}
+	u.MutedTags = tags
+}
+
+// ExcludedTags returns muted tags except the ones in tags, which are requested explicitly.
+func (u *User) ExcludedTags(tags []string) []string {
+	var excluded []string
+	for _, t := range u.MutedTags {
+		if !algo.InStrSlice(tags, t) {
+			excluded = append(excluded, t)
+		}
+	}
+	return excluded
+}
+
 func (u *User) NotiReceivers() []Receiver {
 	return []Receiver{SendToUser(u.ID), SendToGroup(AllUser)}
 }
@@ -158,29 +295,43 @@ func (r Role) Value() int {
 type Action string
 
 const (
-	ActionProfile     = Action("PROFILE")
-	ActionBanUser     = Action("BAN_USER")
-	ActionPromoteUser = Action("PROMOTE_USER")
-	ActionBlockPost   = Action("BLOCK_POST")
-	ActionLockThread  = Action("LOCK_THREAD")
-	ActionBlockThread = Action("BLOCK_THREAD")
-	ActionEditTag     = Action("EDIT_TAG")
-	ActionEditSetting = Action("EDIT_SETTING")
-	ActionPubPost     = Action("PUB_POST")
-	ActionPubThread   = Action("PUB_THREAD")
+	ActionProfile      = Action("PROFILE")
+	ActionBanUser      = Action("BAN_USER")
+	ActionPromoteUser  = Action("PROMOTE_USER")
+	ActionBlockPost    = Action("BLOCK_POST")
+	ActionLockThread   = Action("LOCK_THREAD")
+	ActionBlockThread  = Action("BLOCK_THREAD")
+	ActionPinThread    = Action("PIN_THREAD")
+	ActionViewRevision = Action("VIEW_REVISION")
+	ActionWatchThread  = Action("WATCH_THREAD")
+	ActionBookmark     = Action("BOOKMARK")
+	ActionReport       = Action("REPORT")
+	ActionHandleReport = Action("HANDLE_REPORT")
+	ActionViewModLog   = Action("VIEW_MOD_LOG")
+	ActionEditTag      = Action("EDIT_TAG")
+	ActionEditSetting  = Action("EDIT_SETTING")
+	ActionPubPost      = Action("PUB_POST")
+	ActionPubThread    = Action("PUB_THREAD")
 )
 
 var ActionRole = map[Action]Role{
-	ActionProfile:     RoleBanned, // Because a user can only read the profile own by himself.
-	ActionBanUser:     RoleMod,
-	ActionPromoteUser: RoleAdmin,
-	ActionBlockPost:   RoleMod,
-	ActionLockThread:  RoleMod,
-	ActionBlockThread: RoleMod,
-	ActionEditTag:     RoleMod,
-	ActionEditSetting: RoleAdmin,
-	ActionPubPost:     RoleGuest,
-	ActionPubThread:   RoleGuest,
+	ActionProfile:      RoleBanned, // Because a user can only read the profile own by himself.
+	ActionBanUser:      RoleMod,
+	ActionPromoteUser:  RoleAdmin,
+	ActionBlockPost:    RoleMod,
+	ActionLockThread:   RoleMod,
+	ActionBlockThread:  RoleMod,
+	ActionPinThread:    RoleMod,
+	ActionViewRevision: RoleMod,
+	ActionWatchThread:  RoleNormal,
+	ActionBookmark:     RoleGuest,
+	ActionReport:       RoleGuest,
+	ActionHandleReport: RoleMod,
+	ActionViewModLog:   RoleAdmin,
+	ActionEditTag:      RoleMod,
+	ActionEditSetting:  RoleAdmin,
+	ActionPubPost:      RoleGuest,
+	ActionPubThread:    RoleGuest,
 }
 
 func (u *User) RequirePermission(action Action) error {
@@ -189,7 +340,28 @@ func (u *User) RequirePermission(action Action) error {
 	}
 	needRole := ActionRole[action]
 	if u.Role.Value() < needRole.Value() {
+		if u.Role == RoleBanned {
+			return errors.Permission.With(errors.Extensions{
+				"banReason":    u.BanReason,
+				"banExpiresAt": u.BanExpiresAt,
+			}).New("permission denied, user is banned")
+		}
 		return errors.Permission.New("permission denied")
 	}
 	return nil
 }
+
+// RequireTagPermission is like RequirePermission, but roles granted in the main tag are also counted.
+func (u *User) RequireTagPermission(action Action, mainTag string) error {
+	err := u.RequirePermission(action)
+	if err == nil || u == nil || u.Role == RoleBanned {
+		return err
+	}
+	needRole := ActionRole[action]
+	for _, tr := range u.TagRoles {
+		if tr.Tag == mainTag && tr.Role.Value() >= needRole.Value() {
+			return nil
+		}
+	}
+	return err
+}
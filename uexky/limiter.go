@@ -2,37 +2,188 @@ package uexky
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
 
+	"github.com/go-redis/redis/v7"
+	log "github.com/sirupsen/logrus"
+	"gitlab.com/abyss.club/uexky/lib/config"
 	"gitlab.com/abyss.club/uexky/lib/errors"
+	librd "gitlab.com/abyss.club/uexky/lib/redis"
+	"gitlab.com/abyss.club/uexky/uexky/entity"
 )
 
+// Limiter is a sliding window rate limiter stored in redis.
+// Queries and mutations have separate budgets, see config.RateLimit.
+// Query costs of a graphql operation are summed and charged once, see AttachOperation.
 type Limiter struct {
-	Limit int
-	Count int
+	Redis *redis.Client
 }
 
+type limitType string
+
+const (
+	queryLimit limitType = "q"
+	mutLimit   limitType = "m"
+)
+
 type contextKey int
 
 const (
 	limiterKey contextKey = 1 + iota
+	loadersKey
+	clientIPKey
+	operationKey
 )
 
-func AttachLimiter(ctx context.Context, limit int) context.Context {
-	return context.WithValue(ctx, limiterKey, &Limiter{Limit: limit})
+type clientLimiter struct {
+	limiter    *Limiter
+	ip         string
+	remoteAddr string
 }
 
-func Cost(ctx context.Context, cost int) error {
-	v := ctx.Value(limiterKey)
-	limiter, ok := v.(*Limiter)
-	if !ok || limiter == nil {
+// AttachContext attaches limiter of a client to context. The client is identified by ip if it's not empty,
+// otherwise by current user's id, or remoteAddr if no user found.
+func (l *Limiter) AttachContext(ctx context.Context, ip, remoteAddr string) context.Context {
+	return context.WithValue(ctx, limiterKey, &clientLimiter{
+		limiter:    l,
+		ip:         ip,
+		remoteAddr: remoteAddr,
+	})
+}
+
+func (cl *clientLimiter) clientKey(ctx context.Context) string {
+	if cl.ip != "" {
+		return fmt.Sprintf("ip:%s", cl.ip)
+	}
+	if user := entity.GetCurrentUser(ctx); user != nil {
+		return fmt.Sprintf("u:%s", user.ID.ToBase64String())
+	}
+	return fmt.Sprintf("ip:%s", cl.remoteAddr)
+}
+
+// operation sums query costs of a graphql operation, its fields are resolved concurrently.
+type operation struct {
+	mu    sync.Mutex
+	count int
+}
+
+// AttachOperation attaches a graphql operation to context. Query costs in the operation are
+// summed and limited by config.RateLimit.OperationLimit, ChargeOperation charges the sum.
+func AttachOperation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, operationKey, &operation{})
+}
+
+// ChargeOperation charges the query costs summed since last charge to the budget of current client.
+// Subscriptions are charged for every response.
+func ChargeOperation(ctx context.Context) error {
+	op, ok := ctx.Value(operationKey).(*operation)
+	if !ok || op == nil {
+		return nil
+	}
+	op.mu.Lock()
+	count := op.count
+	op.count = 0
+	op.mu.Unlock()
+	if count == 0 {
 		return nil
 	}
-	limiter.Count += cost
-	if limiter.Count > limiter.Limit {
+	return costOf(ctx, queryLimit, count)
+}
+
+// Cost adds cost to current operation, or charges the query budget of current client if not in an operation.
+func Cost(ctx context.Context, cost int) error {
+	op, ok := ctx.Value(operationKey).(*operation)
+	if !ok || op == nil {
+		return costOf(ctx, queryLimit, cost)
+	}
+	op.mu.Lock()
+	defer op.mu.Unlock()
+	op.count += cost
+	if limit := config.Get().RateLimit.OperationLimit; limit > 0 && op.count > limit {
 		return errors.Complexity.Errorf(
-			"operation has complexity %v at least, which exceeds the limit of %v",
-			limiter.Count, limiter.Limit,
+			"operation has complexity %v at least, which exceeds the limit of %v", op.count, limit,
 		)
 	}
 	return nil
 }
+
+// MutCost charges the mutation budget of current client.
+func MutCost(ctx context.Context, cost int) error {
+	return costOf(ctx, mutLimit, cost)
+}
+
+func costOf(ctx context.Context, t limitType, cost int) error {
+	v := ctx.Value(limiterKey)
+	cl, ok := v.(*clientLimiter)
+	if !ok || cl == nil {
+		return nil
+	}
+	cfg := &config.Get().RateLimit
+	limit, resetTime := cfg.QueryLimit, cfg.QueryResetTime
+	if t == mutLimit {
+		limit, resetTime = cfg.MutLimit, cfg.MutResetTime
+	}
+	key := fmt.Sprintf("limit:%s:%s", t, cl.clientKey(ctx))
+	return cl.limiter.cost(key, limit, time.Duration(resetTime)*time.Second, cost)
+}
+
+// cost uses sliding window counter: the count of previous window is weighted by
+// the part of it still in the sliding window.
+func (l *Limiter) cost(key string, limit int, window time.Duration, cost int) error {
+	if limit <= 0 || window <= 0 {
+		return nil
+	}
+	now := time.Now().UnixNano()
+	index := now / int64(window)
+	elapsed := time.Duration(now % int64(window))
+	curKey := fmt.Sprintf("%s:%v", key, index)
+	prevKey := fmt.Sprintf("%s:%v", key, index-1)
+
+	var cur *redis.IntCmd
+	var prev *redis.StringCmd
+	_, err := l.Redis.TxPipelined(func(pipe redis.Pipeliner) error {
+		cur = pipe.IncrBy(curKey, int64(cost))
+		pipe.Expire(curKey, 2*window)
+		prev = pipe.Get(prevKey)
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return librd.ErrHandlef(err, "LimiterCost(key=%s, cost=%v)", key, cost)
+	}
+	prevCount, _ := prev.Float64()
+	curCount := float64(cur.Val())
+	ratio := 1 - float64(elapsed)/float64(window)
+	if prevCount*ratio+curCount <= float64(limit) {
+		return nil
+	}
+
+	// refund the rejected request, so that client can retry after the returned time.
+	if _, err := l.Redis.DecrBy(curKey, int64(cost)).Result(); err != nil {
+		log.Error(librd.ErrHandlef(err, "LimiterRefund(key=%s, cost=%v)", key, cost))
+	}
+	retryAfter := retryAfter(float64(limit), window, elapsed, prevCount, curCount, float64(cost))
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	return errors.Complexity.With(errors.Extensions{"retryAfter": seconds}).Errorf(
+		"rate limit exceeded, retry after %v seconds", seconds,
+	)
+}
+
+// retryAfter calculates the time when the estimated count of sliding window is
+// reduced enough to accept the cost. curCount has already contained the cost.
+func retryAfter(limit float64, window, elapsed time.Duration, prevCount, curCount, cost float64) time.Duration {
+	if curCount <= limit {
+		// wait in current window, prevCount*(1-(elapsed+t)/window)+curCount <= limit
+		t := float64(window)*(1-(limit-curCount)/prevCount) - float64(elapsed)
+		return time.Duration(t)
+	}
+	// wait to next window, (curCount-cost)*(1-t/window)+cost <= limit
+	rest := window - elapsed
+	if cost >= limit || curCount-cost <= 0 {
+		return rest
+	}
+	t := float64(window) * (1 - (limit-cost)/(curCount-cost))
+	return rest + time.Duration(t)
+}
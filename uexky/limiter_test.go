@@ -0,0 +1,132 @@
+package uexky
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+	"gitlab.com/abyss.club/uexky/lib/config"
+	"gitlab.com/abyss.club/uexky/lib/errors"
+)
+
+func Test_retryAfter(t *testing.T) {
+	type args struct {
+		limit     float64
+		window    time.Duration
+		elapsed   time.Duration
+		prevCount float64
+		curCount  float64
+		cost      float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want time.Duration
+	}{
+		{
+			name: "wait in current window",
+			args: args{
+				limit: 10, window: 100 * time.Second, elapsed: 20 * time.Second,
+				prevCount: 10, curCount: 5, cost: 1,
+			},
+			want: 30 * time.Second, // 10*(1-50/100)+5 = 10
+		},
+		{
+			name: "wait to next window",
+			args: args{
+				limit: 10, window: 100 * time.Second, elapsed: 60 * time.Second,
+				prevCount: 0, curCount: 12, cost: 2,
+			},
+			want: 60 * time.Second, // 40s to next window, 10*(1-20/100)+2 = 10
+		},
+		{
+			name: "cost exceeds limit",
+			args: args{
+				limit: 10, window: 100 * time.Second, elapsed: 60 * time.Second,
+				prevCount: 0, curCount: 20, cost: 20,
+			},
+			want: 40 * time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := retryAfter(tt.args.limit, tt.args.window, tt.args.elapsed, tt.args.prevCount, tt.args.curCount, tt.args.cost)
+			if got.Round(time.Millisecond) != tt.want {
+				t.Errorf("retryAfter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// countingHook counts redis calls and fails them, so that no data is written.
+type countingHook struct {
+	calls int32
+}
+
+var errHooked = errors.Internal.New("redis call is hooked")
+
+func (h *countingHook) BeforeProcess(ctx context.Context, cmd redis.Cmder) (context.Context, error) {
+	atomic.AddInt32(&h.calls, 1)
+	return ctx, errHooked
+}
+
+func (h *countingHook) AfterProcess(ctx context.Context, cmd redis.Cmder) error {
+	return nil
+}
+
+func (h *countingHook) BeforeProcessPipeline(ctx context.Context, cmds []redis.Cmder) (context.Context, error) {
+	atomic.AddInt32(&h.calls, 1)
+	return ctx, errHooked
+}
+
+func (h *countingHook) AfterProcessPipeline(ctx context.Context, cmds []redis.Cmder) error {
+	return nil
+}
+
+func TestCost_operation(t *testing.T) {
+	rd := redis.NewClient(&redis.Options{})
+	hook := &countingHook{}
+	rd.AddHook(hook)
+	limiter := &Limiter{Redis: rd}
+	ctx := limiter.AttachContext(context.Background(), "127.0.0.1", "127.0.0.1:1234")
+	ctx = AttachOperation(ctx)
+
+	// fields of a query are resolved concurrently, each of them costs.
+	wg := &sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := Cost(ctx, 1); err != nil {
+				t.Errorf("Cost() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls := atomic.LoadInt32(&hook.calls); calls != 0 {
+		t.Errorf("redis calls before charge = %v, want 0", calls)
+	}
+	if err := ChargeOperation(ctx); !errors.Is(err, errHooked) {
+		t.Errorf("ChargeOperation() error = %v, want %v", err, errHooked)
+	}
+	if calls := atomic.LoadInt32(&hook.calls); calls != 1 {
+		t.Errorf("redis calls after charge = %v, want 1", calls)
+	}
+	if err := ChargeOperation(ctx); err != nil {
+		t.Errorf("ChargeOperation() without cost error = %v", err)
+	}
+	if calls := atomic.LoadInt32(&hook.calls); calls != 1 {
+		t.Errorf("redis calls after charge without cost = %v, want 1", calls)
+	}
+
+	limit := config.Get().RateLimit.OperationLimit
+	if err := Cost(ctx, limit); err != nil {
+		t.Errorf("Cost(limit) error = %v", err)
+	}
+	if err := Cost(ctx, 1); !errors.Is(err, errors.Complexity) {
+		t.Errorf("Cost() over operation limit error = %v, want complexity error", err)
+	}
+}
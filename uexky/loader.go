@@ -0,0 +1,136 @@
+package uexky
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"gitlab.com/abyss.club/uexky/lib/uid"
+	"gitlab.com/abyss.club/uexky/uexky/entity"
+)
+
+// loaderWait is how long a loader waits to collect keys before fetching.
+const loaderWait = 2 * time.Millisecond
+
+type fetchFunc func(ctx context.Context, ids []uid.UID) (map[uid.UID]interface{}, error)
+
+// loader batches loads of the same field in a request into one fetch.
+type loader struct {
+	fetch fetchFunc
+
+	mu    sync.Mutex
+	batch *loaderBatch
+}
+
+type loaderBatch struct {
+	ids     []uid.UID
+	idSet   map[uid.UID]bool
+	done    chan struct{}
+	results map[uid.UID]interface{}
+	err     error
+}
+
+func (l *loader) Load(ctx context.Context, id uid.UID) (interface{}, error) {
+	l.mu.Lock()
+	b := l.batch
+	if b == nil {
+		b = &loaderBatch{idSet: map[uid.UID]bool{}, done: make(chan struct{})}
+		l.batch = b
+		go l.run(ctx, b)
+	}
+	if !b.idSet[id] {
+		b.idSet[id] = true
+		b.ids = append(b.ids, id)
+	}
+	l.mu.Unlock()
+
+	<-b.done
+	return b.results[id], b.err
+}
+
+func (l *loader) run(ctx context.Context, b *loaderBatch) {
+	time.Sleep(loaderWait)
+	l.mu.Lock()
+	l.batch = nil
+	l.mu.Unlock()
+
+	b.results, b.err = l.fetch(ctx, b.ids)
+	close(b.done)
+}
+
+type loaders struct {
+	post        loader
+	quotedCount loader
+	replyCount  loader
+}
+
+// AttachLoaders attaches request-scoped loaders to context, post and count fields are batched by them.
+func (s *Service) AttachLoaders(ctx context.Context) context.Context {
+	ls := &loaders{
+		post: loader{fetch: func(ctx context.Context, ids []uid.UID) (map[uid.UID]interface{}, error) {
+			posts, err := s.Repo.Post.GetByIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			m := make(map[uid.UID]interface{}, len(posts))
+			for _, p := range posts {
+				m[p.ID] = p
+			}
+			return m, nil
+		}},
+		quotedCount: loader{fetch: func(ctx context.Context, ids []uid.UID) (map[uid.UID]interface{}, error) {
+			return countsResult(s.Repo.Post.QuotedCounts(ctx, ids))
+		}},
+		replyCount: loader{fetch: func(ctx context.Context, ids []uid.UID) (map[uid.UID]interface{}, error) {
+			return countsResult(s.Repo.Thread.ReplyCounts(ctx, ids))
+		}},
+	}
+	return context.WithValue(ctx, loadersKey, ls)
+}
+
+func countsResult(counts map[uid.UID]int, err error) (map[uid.UID]interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[uid.UID]interface{}, len(counts))
+	for id, c := range counts {
+		m[id] = c
+	}
+	return m, nil
+}
+
+func getLoaders(ctx context.Context) *loaders {
+	ls, _ := ctx.Value(loadersKey).(*loaders)
+	return ls
+}
+
+func (ls *loaders) loadPosts(ctx context.Context, ids []uid.UID) ([]*entity.Post, error) {
+	posts := make([]*entity.Post, len(ids))
+	errs := make([]error, len(ids))
+	var wg sync.WaitGroup
+	for i := range ids {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var v interface{}
+			v, errs[i] = ls.post.Load(ctx, ids[i])
+			posts[i], _ = v.(*entity.Post)
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return posts, nil
+}
+
+func loadCount(ctx context.Context, l *loader, id uid.UID) (int, error) {
+	v, err := l.Load(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	count, _ := v.(int)
+	return count, nil
+}
@@ -0,0 +1,62 @@
+package uexky
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"gitlab.com/abyss.club/uexky/lib/errors"
+	"gitlab.com/abyss.club/uexky/lib/uid"
+)
+
+func Test_loader(t *testing.T) {
+	var mu sync.Mutex
+	var fetched [][]uid.UID
+	l := &loader{fetch: func(ctx context.Context, ids []uid.UID) (map[uid.UID]interface{}, error) {
+		mu.Lock()
+		fetched = append(fetched, ids)
+		mu.Unlock()
+		m := map[uid.UID]interface{}{}
+		for _, id := range ids {
+			if id%2 == 0 {
+				m[id] = int(id) * 10
+			}
+		}
+		return m, nil
+	}}
+
+	ids := []uid.UID{1, 2, 3, 4, 2}
+	got := make([]int, len(ids))
+	var wg sync.WaitGroup
+	for i := range ids {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			count, err := loadCount(context.Background(), l, ids[i])
+			if err != nil {
+				t.Error(err)
+			}
+			got[i] = count
+		}(i)
+	}
+	wg.Wait()
+
+	want := []int{0, 20, 0, 40, 20}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("loadCount(%v) = %v, want %v", ids[i], got[i], want[i])
+		}
+	}
+	if len(fetched) != 1 || len(fetched[0]) != 4 {
+		t.Errorf("loader fetched %v, want one batch of 4 ids", fetched)
+	}
+
+	t.Run("error", func(t *testing.T) {
+		l := &loader{fetch: func(ctx context.Context, ids []uid.UID) (map[uid.UID]interface{}, error) {
+			return nil, errors.Internal.New("fetch failed")
+		}}
+		if _, err := l.Load(context.Background(), 1); !errors.Is(err, errors.Internal) {
+			t.Errorf("Load() error = %v, want Internal", err)
+		}
+	})
+}
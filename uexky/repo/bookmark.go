@@ -0,0 +1,88 @@
+package repo
+
+import (
+	"context"
+
+	"gitlab.com/abyss.club/uexky/lib/errors"
+	"gitlab.com/abyss.club/uexky/lib/postgres"
+	"gitlab.com/abyss.club/uexky/lib/uid"
+	"gitlab.com/abyss.club/uexky/uexky/entity"
+)
+
+// BookmarkRepo stores bookmarks of both signed in users and guests,
+// so user_id is not referenced to the user table.
+type BookmarkRepo struct{}
+
+func (r *BookmarkRepo) Insert(ctx context.Context, userID uid.UID, item entity.BookmarkItem) error {
+	b := &Bookmark{UserID: userID, SortKey: uid.NewUID()}
+	switch i := item.(type) {
+	case *entity.Thread:
+		b.TargetID, b.Kind = i.ID, kindThread
+	case *entity.Post:
+		b.TargetID, b.Kind = i.ID, kindPost
+	default:
+		return errors.BadParams.Errorf("can not bookmark %T", item)
+	}
+	_, err := db(ctx).Model(b).OnConflict("DO NOTHING").Insert()
+	return postgres.ErrHandlef(err, "InsertBookmark(userID=%v, item=%v)", userID, item)
+}
+
+func (r *BookmarkRepo) Delete(ctx context.Context, userID uid.UID, targetID uid.UID) error {
+	_, err := db(ctx).Model((*Bookmark)(nil)).
+		Where("user_id = ?", userID).Where("target_id = ?", targetID).Delete()
+	return postgres.ErrHandlef(err, "DeleteBookmark(userID=%v, targetID=%v)", userID, targetID)
+}
+
+func (r *BookmarkRepo) IsBookmarked(ctx context.Context, userID uid.UID, targetID uid.UID) (bool, error) {
+	exists, err := db(ctx).Model((*Bookmark)(nil)).
+		Where("user_id = ?", userID).Where("target_id = ?", targetID).Exists()
+	return exists, postgres.ErrHandlef(err, "IsBookmarked(userID=%v, targetID=%v)", userID, targetID)
+}
+
+func (r *BookmarkRepo) GetSlice(
+	ctx context.Context, userID uid.UID, query entity.SliceQuery,
+) (*entity.BookmarkSlice, error) {
+	var bookmarks []Bookmark
+	h := sliceHelper{
+		Column:      "sort_key",
+		Desc:        true,
+		TransCursor: func(s string) (interface{}, error) { return uid.ParseUID(s) },
+		SQ:          &query,
+	}
+	q := db(ctx).Model(&bookmarks).Where("user_id = ?", userID)
+	if err := h.Select(q); err != nil {
+		return nil, postgres.ErrHandlef(err, "GetBookmarkSlice(userID=%v, query=%+v)", userID, query)
+	}
+	var bs []*Bookmark
+	h.DealResults(len(bookmarks), func(i int) {
+		bs = append(bs, &bookmarks[i])
+	})
+
+	var threadIDs, postIDs []uid.UID
+	for _, b := range bs {
+		if b.Kind == kindThread {
+			threadIDs = append(threadIDs, b.TargetID)
+		} else {
+			postIDs = append(postIDs, b.TargetID)
+		}
+	}
+	itemMap, err := loadThreadsAndPosts(ctx, threadIDs, postIDs)
+	if err != nil {
+		return nil, err
+	}
+	var items []entity.BookmarkItem
+	for _, b := range bs {
+		if item, ok := itemMap[b.TargetID]; ok {
+			items = append(items, item.(entity.BookmarkItem))
+		}
+	}
+	sliceInfo := &entity.SliceInfo{HasNext: len(bookmarks) > query.Limit}
+	if len(bs) > 0 {
+		sliceInfo.FirstCursor = bs[0].SortKey.ToBase64String()
+		sliceInfo.LastCursor = bs[len(bs)-1].SortKey.ToBase64String()
+	}
+	return &entity.BookmarkSlice{
+		Items:     items,
+		SliceInfo: sliceInfo,
+	}, nil
+}
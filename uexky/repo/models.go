@@ -3,6 +3,7 @@ package repo
 import (
 	"time"
 
+	"gitlab.com/abyss.club/uexky/lib/algo"
 	"gitlab.com/abyss.club/uexky/lib/config"
 	"gitlab.com/abyss.club/uexky/lib/errors"
 	"gitlab.com/abyss.club/uexky/lib/uid"
@@ -13,14 +14,20 @@ type User struct {
 	//nolint: structcheck, unused
 	tableName struct{} `pg:"user,,discard_unknown_columns"`
 
-	ID           uid.UID     `pg:"id,pk" json:"id"`
-	CreatedAt    time.Time   `pg:"created_at" json:"created_at"`
-	UpdatedAt    time.Time   `pg:"updated_at" json:"updated_at"`
-	Email        *string     `pg:"email,use_zero" json:"-"`
-	Name         *string     `pg:"name,user_zero" json:"-"`
-	Role         entity.Role `pg:"role,use_zero" json:"role"`
-	LastReadNoti uid.UID     `pg:"last_read_noti,use_zero" json:"-"`
-	Tags         []string    `pg:"tags,array" json:"tags"`
+	ID           uid.UID          `pg:"id,pk" json:"id"`
+	CreatedAt    time.Time        `pg:"created_at" json:"created_at"`
+	UpdatedAt    time.Time        `pg:"updated_at" json:"updated_at"`
+	Email        *string          `pg:"email,use_zero" json:"-"`
+	Name         *string          `pg:"name,user_zero" json:"-"`
+	Role         entity.Role      `pg:"role,use_zero" json:"role"`
+	LastReadNoti uid.UID          `pg:"last_read_noti,use_zero" json:"-"`
+	Tags         []string         `pg:"tags,array" json:"tags"`
+	AutoWatch    bool             `pg:"auto_watch,use_zero" json:"auto_watch"`
+	PrevRole     *entity.Role     `pg:"prev_role" json:"prev_role"`
+	BanReason    *string          `pg:"ban_reason" json:"ban_reason"`
+	BanExpiresAt *time.Time       `pg:"ban_expires_at" json:"ban_expires_at"`
+	TagRoles     []entity.TagRole `pg:"tag_roles" json:"tag_roles"`
+	MutedTags    []string         `pg:"muted_tags,array" json:"muted_tags"`
 }
 
 func NewUserFromEntity(user *entity.User) *User {
@@ -32,6 +39,12 @@ func NewUserFromEntity(user *entity.User) *User {
 		Role:         user.Role,
 		LastReadNoti: user.LastReadNoti,
 		Tags:         user.Tags,
+		MutedTags:    user.MutedTags,
+		AutoWatch:    user.AutoWatch,
+		PrevRole:     user.PrevRole,
+		BanReason:    user.BanReason,
+		BanExpiresAt: user.BanExpiresAt,
+		TagRoles:     user.TagRoles,
 	}
 }
 
@@ -42,7 +55,13 @@ func (u *User) ToEntity() *entity.User {
 		Name:         u.Name,
 		Role:         u.Role,
 		Tags:         u.Tags,
+		MutedTags:    u.MutedTags,
 		LastReadNoti: u.LastReadNoti,
+		AutoWatch:    u.AutoWatch,
+		PrevRole:     u.PrevRole,
+		BanReason:    u.BanReason,
+		BanExpiresAt: u.BanExpiresAt,
+		TagRoles:     u.TagRoles,
 	}
 	// TODO: should in service level?
 	if len(user.Tags) == 0 {
@@ -71,6 +90,13 @@ type Thread struct {
 	Locked     bool      `pg:"locked,use_zero"`
 	Blocked    bool      `pg:"blocked,use_zero"`
 	Tags       []string  `pg:"tags,array"`
+	ReplyCount int       `pg:"reply_count,use_zero"`
+	Hot        float64   `pg:"hot,use_zero"`
+
+	Pinned      bool       `pg:"pinned,use_zero"`
+	PinnedUntil *time.Time `pg:"pinned_until"`
+	EditedAt    *time.Time `pg:"edited_at"`
+	IP          *string    `pg:"ip,type:inet"`
 }
 
 func NewThreadFromEntity(thread *entity.Thread) *Thread {
@@ -87,6 +113,11 @@ func NewThreadFromEntity(thread *entity.Thread) *Thread {
 		Locked:     thread.Locked,
 		Blocked:    thread.Blocked,
 		Tags:       []string{thread.MainTag},
+
+		Pinned:      thread.Pinned,
+		PinnedUntil: thread.PinnedUntil,
+		EditedAt:    thread.EditedAt,
+		IP:          nullIP(thread.Author.IP),
 	}
 	t.Tags = append(t.Tags, thread.SubTags...)
 	if !thread.Blocked {
@@ -105,13 +136,20 @@ func (t *Thread) ToEntity() *entity.Thread {
 			Guest:     t.Guest,
 			Anonymous: t.Anonymous,
 			Author:    t.Author,
+			IsOP:      true,
+			IP:        algo.NullToString(t.IP),
 		},
-		Title:   t.Title,
-		Content: t.Content,
-		MainTag: t.Tags[0],
-		SubTags: t.Tags[1:],
-		Blocked: t.Blocked,
-		Locked:  t.Locked,
+		Title:    t.Title,
+		Content:  t.Content,
+		MainTag:  t.Tags[0],
+		SubTags:  t.Tags[1:],
+		Blocked:  t.Blocked,
+		Locked:   t.Locked,
+		EditedAt: t.EditedAt,
+	}
+	if t.Pinned && (t.PinnedUntil == nil || t.PinnedUntil.After(time.Now())) {
+		thread.Pinned = true
+		thread.PinnedUntil = t.PinnedUntil
 	}
 	if thread.Blocked {
 		thread.Content = entity.BlockedContent
@@ -123,17 +161,20 @@ type Post struct {
 	//nolint: structcheck, unused
 	tableName struct{} `pg:"post,,discard_unknown_columns"`
 
-	ID        uid.UID   `pg:"id,pk"`
-	CreatedAt time.Time `pg:"created_at"`
-	UpdatedAt time.Time `pg:"updated_at"`
-	ThreadID  uid.UID   `pg:"thread_id,use_zero"`
-	UserID    uid.UID   `pg:"user_id,use_zero"`
-	Anonymous bool      `pg:"anonymous,use_zero"`
-	Guest     bool      `pg:"guest,use_zero"`
-	Author    string    `pg:"author"`
-	Blocked   bool      `pg:"blocked"`
-	Content   string    `pg:"content,use_zero"`
-	QuotedIDs []uid.UID `pg:"quoted_ids,array"`
+	ID        uid.UID    `pg:"id,pk"`
+	CreatedAt time.Time  `pg:"created_at"`
+	UpdatedAt time.Time  `pg:"updated_at"`
+	ThreadID  uid.UID    `pg:"thread_id,use_zero"`
+	UserID    uid.UID    `pg:"user_id,use_zero"`
+	Anonymous bool       `pg:"anonymous,use_zero"`
+	Guest     bool       `pg:"guest,use_zero"`
+	Author    string     `pg:"author"`
+	IsOP      bool       `pg:"is_op,use_zero"`
+	Blocked   bool       `pg:"blocked"`
+	Content   string     `pg:"content,use_zero"`
+	QuotedIDs []uid.UID  `pg:"quoted_ids,array"`
+	EditedAt  *time.Time `pg:"edited_at"`
+	IP        *string    `pg:"ip,type:inet"`
 }
 
 func NewPostFromEntity(post *entity.Post) *Post {
@@ -146,8 +187,11 @@ func NewPostFromEntity(post *entity.Post) *Post {
 		Anonymous: post.Author.Anonymous,
 		Guest:     post.Author.Guest,
 		Author:    post.Author.Author,
+		IsOP:      post.Author.IsOP,
 		Blocked:   post.Blocked,
 		QuotedIDs: post.QuoteIDs,
+		EditedAt:  post.EditedAt,
+		IP:        nullIP(post.Author.IP),
 	}
 	if !p.Blocked {
 		p.Content = post.Content
@@ -165,10 +209,13 @@ func (p *Post) ToEntity() *entity.Post {
 			Guest:     p.Guest,
 			Anonymous: p.Anonymous,
 			Author:    p.Author,
+			IsOP:      p.IsOP,
+			IP:        algo.NullToString(p.IP),
 		},
 		QuoteIDs: p.QuotedIDs,
 		Content:  p.Content,
 		Blocked:  p.Blocked,
+		EditedAt: p.EditedAt,
 	}
 	if post.Blocked {
 		post.Content = entity.BlockedContent
@@ -176,14 +223,252 @@ func (p *Post) ToEntity() *entity.Post {
 	return post
 }
 
+type ThreadWatch struct {
+	//nolint: structcheck, unused
+	tableName struct{} `pg:"thread_watch,,discard_unknown_columns"`
+
+	ThreadID  uid.UID   `pg:"thread_id,pk"`
+	UserID    uid.UID   `pg:"user_id,pk"`
+	CreatedAt time.Time `pg:"created_at"`
+}
+
+type HiddenThread struct {
+	//nolint: structcheck, unused
+	tableName struct{} `pg:"hidden_thread,,discard_unknown_columns"`
+
+	UserID    uid.UID   `pg:"user_id,pk"`
+	ThreadID  uid.UID   `pg:"thread_id,pk"`
+	SortKey   uid.UID   `pg:"sort_key,use_zero"`
+	CreatedAt time.Time `pg:"created_at"`
+}
+
+type Bookmark struct {
+	//nolint: structcheck, unused
+	tableName struct{} `pg:"bookmark,,discard_unknown_columns"`
+
+	UserID    uid.UID   `pg:"user_id,pk"`
+	TargetID  uid.UID   `pg:"target_id,pk"`
+	Kind      string    `pg:"kind,use_zero"`
+	SortKey   uid.UID   `pg:"sort_key,use_zero"`
+	CreatedAt time.Time `pg:"created_at"`
+}
+
+type Report struct {
+	//nolint: structcheck, unused
+	tableName struct{} `pg:"report,,discard_unknown_columns"`
+
+	ID         uid.UID             `pg:"id,pk"`
+	CreatedAt  time.Time           `pg:"created_at"`
+	ReporterID uid.UID             `pg:"reporter_id,use_zero"`
+	TargetID   uid.UID             `pg:"target_id,use_zero"`
+	ThreadID   uid.UID             `pg:"thread_id,use_zero"`
+	PostID     *uid.UID            `pg:"post_id"`
+	Reason     entity.ReportReason `pg:"reason,use_zero"`
+	Text       *string             `pg:"text"`
+	Status     entity.ReportStatus `pg:"status,use_zero"`
+	HandlerID  *uid.UID            `pg:"handler_id"`
+	HandledAt  *time.Time          `pg:"handled_at"`
+}
+
+func NewReportFromEntity(report *entity.Report) *Report {
+	r := &Report{
+		ID:         report.ID,
+		CreatedAt:  report.CreatedAt,
+		ReporterID: report.ReporterID,
+		TargetID:   report.ThreadID,
+		ThreadID:   report.ThreadID,
+		PostID:     report.PostID,
+		Reason:     report.Reason,
+		Text:       report.Text,
+		Status:     report.Status,
+		HandlerID:  report.HandlerID,
+		HandledAt:  report.HandledAt,
+	}
+	if report.PostID != nil {
+		r.TargetID = *report.PostID
+	}
+	return r
+}
+
+func (r *Report) ToEntity() *entity.Report {
+	return &entity.Report{
+		ID:         r.ID,
+		CreatedAt:  r.CreatedAt,
+		ReporterID: r.ReporterID,
+		ThreadID:   r.ThreadID,
+		PostID:     r.PostID,
+		Reason:     r.Reason,
+		Text:       r.Text,
+		Status:     r.Status,
+		HandlerID:  r.HandlerID,
+		HandledAt:  r.HandledAt,
+	}
+}
+
+type ModLog struct {
+	//nolint: structcheck, unused
+	tableName struct{} `pg:"mod_log,,discard_unknown_columns"`
+
+	ID        uid.UID          `pg:"id,pk"`
+	CreatedAt time.Time        `pg:"created_at"`
+	ActorID   uid.UID          `pg:"actor_id,use_zero"`
+	Action    entity.ModAction `pg:"action,use_zero"`
+	TargetID  uid.UID          `pg:"target_id,use_zero"`
+	Reason    *string          `pg:"reason"`
+	Before    string           `pg:"before,use_zero"`
+	After     string           `pg:"after,use_zero"`
+}
+
+func NewModLogFromEntity(l *entity.ModLog) *ModLog {
+	return &ModLog{
+		ID:        l.ID,
+		CreatedAt: l.CreatedAt,
+		ActorID:   l.ActorID,
+		Action:    l.Action,
+		TargetID:  l.TargetID,
+		Reason:    l.Reason,
+		Before:    l.Before,
+		After:     l.After,
+	}
+}
+
+func (l *ModLog) ToEntity() *entity.ModLog {
+	return &entity.ModLog{
+		ID:        l.ID,
+		CreatedAt: l.CreatedAt,
+		ActorID:   l.ActorID,
+		Action:    l.Action,
+		TargetID:  l.TargetID,
+		Reason:    l.Reason,
+		Before:    l.Before,
+		After:     l.After,
+	}
+}
+
+type NetBan struct {
+	//nolint: structcheck, unused
+	tableName struct{} `pg:"net_ban,,discard_unknown_columns"`
+
+	ID        uid.UID    `pg:"id,pk"`
+	CreatedAt time.Time  `pg:"created_at"`
+	Network   string     `pg:"network,type:cidr"`
+	TargetID  uid.UID    `pg:"target_id,use_zero"`
+	CreatorID uid.UID    `pg:"creator_id,use_zero"`
+	Reason    *string    `pg:"reason"`
+	ExpiresAt *time.Time `pg:"expires_at"`
+}
+
+func NewNetBanFromEntity(b *entity.NetBan) *NetBan {
+	return &NetBan{
+		ID:        b.ID,
+		CreatedAt: b.CreatedAt,
+		Network:   b.Network,
+		TargetID:  b.TargetID,
+		CreatorID: b.CreatorID,
+		Reason:    b.Reason,
+		ExpiresAt: b.ExpiresAt,
+	}
+}
+
+func (b *NetBan) ToEntity() *entity.NetBan {
+	return &entity.NetBan{
+		ID:        b.ID,
+		CreatedAt: b.CreatedAt,
+		Network:   b.Network,
+		TargetID:  b.TargetID,
+		CreatorID: b.CreatorID,
+		Reason:    b.Reason,
+		ExpiresAt: b.ExpiresAt,
+	}
+}
+
+// nullIP stores empty ip as NULL, which is not a valid inet.
+func nullIP(ip string) *string {
+	if ip == "" {
+		return nil
+	}
+	return &ip
+}
+
+type Revision struct {
+	//nolint: structcheck, unused
+	tableName struct{} `pg:"revision,,discard_unknown_columns"`
+
+	ID        uid.UID   `pg:"id,pk"`
+	TargetID  uid.UID   `pg:"target_id,use_zero"`
+	CreatedAt time.Time `pg:"created_at"`
+	Title     *string   `pg:"title"`
+	Content   string    `pg:"content,use_zero"`
+}
+
+func NewRevisionFromEntity(revision *entity.Revision) *Revision {
+	return &Revision{
+		ID:        revision.ID,
+		TargetID:  revision.TargetID,
+		CreatedAt: revision.CreatedAt,
+		Title:     revision.Title,
+		Content:   revision.Content,
+	}
+}
+
+func (r *Revision) ToEntity() *entity.Revision {
+	return &entity.Revision{
+		ID:        r.ID,
+		TargetID:  r.TargetID,
+		CreatedAt: r.CreatedAt,
+		Title:     r.Title,
+		Content:   r.Content,
+	}
+}
+
 type Tag struct {
 	//nolint: structcheck, unused
 	tableName struct{} `pg:"tag,,discard_unknown_columns"`
 
-	Name      string    `pg:"name,pk"`
+	Name        string    `pg:"name,pk"`
+	CreatedAt   time.Time `pg:"created_at"`
+	UpdatedAt   time.Time `pg:"updated_at"`
+	TagType     *string   `pg:"type,use_zero"`
+	SortOrder   int       `pg:"sort_order,use_zero"`
+	Description *string   `pg:"description"`
+	Rules       *string   `pg:"rules"`
+}
+
+type TagStat struct {
+	//nolint: structcheck, unused
+	tableName struct{} `pg:"tag_stat,,discard_unknown_columns"`
+
+	Name            string     `pg:"name,pk"`
+	ThreadCount     int        `pg:"thread_count,use_zero"`
+	SubscriberCount int        `pg:"subscriber_count,use_zero"`
+	ActiveAt        *time.Time `pg:"active_at"`
+	Pinyin          *string    `pg:"pinyin"`
+	Initials        *string    `pg:"initials"`
+}
+
+// ToEntity merges stat and info of tag, info is nil for sub tags.
+func (s *TagStat) ToEntity(info *Tag) *entity.Tag {
+	tag := &entity.Tag{
+		Name:            s.Name,
+		IsMain:          config.IsMainTag(s.Name),
+		ThreadCount:     s.ThreadCount,
+		SubscriberCount: s.SubscriberCount,
+		ActiveAt:        s.ActiveAt,
+	}
+	if info != nil {
+		tag.Description = info.Description
+		tag.Rules = info.Rules
+	}
+	return tag
+}
+
+type TagAlias struct {
+	//nolint: structcheck, unused
+	tableName struct{} `pg:"tag_alias,,discard_unknown_columns"`
+
+	Alias     string    `pg:"alias,pk"`
 	CreatedAt time.Time `pg:"created_at"`
-	UpdatedAt time.Time `pg:"updated_at"`
-	TagType   *string   `pg:"type,use_zero"`
+	Tag       string    `pg:"tag"`
 }
 
 type Notification struct {
@@ -0,0 +1,42 @@
+package repo
+
+import (
+	"context"
+
+	"gitlab.com/abyss.club/uexky/lib/postgres"
+	"gitlab.com/abyss.club/uexky/lib/uid"
+	"gitlab.com/abyss.club/uexky/uexky/entity"
+)
+
+type ModLogRepo struct{}
+
+func (r *ModLogRepo) Insert(ctx context.Context, l *entity.ModLog) error {
+	_, err := db(ctx).Model(NewModLogFromEntity(l)).Insert()
+	return postgres.ErrHandlef(err, "InsertModLog(log=%+v)", l)
+}
+
+func (r *ModLogRepo) GetSlice(ctx context.Context, query entity.SliceQuery) (*entity.ModLogSlice, error) {
+	var logs []ModLog
+	var entities []*entity.ModLog
+	h := sliceHelper{
+		Column:      "id",
+		Desc:        true,
+		TransCursor: func(s string) (interface{}, error) { return uid.ParseUID(s) },
+		SQ:          &query,
+	}
+	if err := h.Select(db(ctx).Model(&logs)); err != nil {
+		return nil, postgres.ErrHandlef(err, "GetModLogSlice(query=%+v)", query)
+	}
+	h.DealResults(len(logs), func(i int) {
+		entities = append(entities, (&logs[i]).ToEntity())
+	})
+	sliceInfo := &entity.SliceInfo{HasNext: len(logs) > query.Limit}
+	if len(entities) > 0 {
+		sliceInfo.FirstCursor = entities[0].ID.ToBase64String()
+		sliceInfo.LastCursor = entities[len(entities)-1].ID.ToBase64String()
+	}
+	return &entity.ModLogSlice{
+		Logs:      entities,
+		SliceInfo: sliceInfo,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/go-pg/pg/v9"
+	"gitlab.com/abyss.club/uexky/lib/postgres"
+	"gitlab.com/abyss.club/uexky/lib/uid"
+	"gitlab.com/abyss.club/uexky/uexky/entity"
+)
+
+type NetBanRepo struct{}
+
+func (r *NetBanRepo) Insert(ctx context.Context, ban *entity.NetBan) error {
+	_, err := db(ctx).Model(NewNetBanFromEntity(ban)).Insert()
+	return postgres.ErrHandlef(err, "InsertNetBan(ban=%+v)", ban)
+}
+
+func (r *NetBanRepo) GetByID(ctx context.Context, id uid.UID) (*entity.NetBan, error) {
+	var ban NetBan
+	if err := db(ctx).Model(&ban).Where("id = ?", id).Select(); err != nil {
+		return nil, postgres.ErrHandlef(err, "GetNetBan(id=%v)", id)
+	}
+	return ban.ToEntity(), nil
+}
+
+func (r *NetBanRepo) Delete(ctx context.Context, id uid.UID) error {
+	_, err := db(ctx).Model((*NetBan)(nil)).Where("id = ?", id).Delete()
+	return postgres.ErrHandlef(err, "DeleteNetBan(id=%v)", id)
+}
+
+func (r *NetBanRepo) Match(ctx context.Context, ip string) (*entity.NetBan, error) {
+	var ban NetBan
+	err := db(ctx).Model(&ban).
+		Where("network >>= ?::inet", ip).
+		Where("expires_at IS NULL OR expires_at > now()").
+		Order("expires_at DESC NULLS FIRST").Limit(1).Select()
+	if err != nil {
+		if err == pg.ErrNoRows {
+			return nil, nil
+		}
+		return nil, postgres.ErrHandlef(err, "MatchNetBan(ip=%v)", ip)
+	}
+	return ban.ToEntity(), nil
+}
+
+func (r *NetBanRepo) GetSlice(ctx context.Context, query entity.SliceQuery) (*entity.NetBanSlice, error) {
+	var bans []NetBan
+	var entities []*entity.NetBan
+	h := sliceHelper{
+		Column:      "id",
+		Desc:        true,
+		TransCursor: func(s string) (interface{}, error) { return uid.ParseUID(s) },
+		SQ:          &query,
+	}
+	if err := h.Select(db(ctx).Model(&bans)); err != nil {
+		return nil, postgres.ErrHandlef(err, "GetNetBanSlice(query=%+v)", query)
+	}
+	h.DealResults(len(bans), func(i int) {
+		entities = append(entities, (&bans[i]).ToEntity())
+	})
+	sliceInfo := &entity.SliceInfo{HasNext: len(bans) > query.Limit}
+	if len(entities) > 0 {
+		sliceInfo.FirstCursor = entities[0].ID.ToBase64String()
+		sliceInfo.LastCursor = entities[len(entities)-1].ID.ToBase64String()
+	}
+	return &entity.NetBanSlice{
+		Bans:      entities,
+		SliceInfo: sliceInfo,
+	}, nil
+}
@@ -2,15 +2,21 @@ package repo
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/go-pg/pg/v9"
 	"github.com/go-pg/pg/v9/orm"
+	"github.com/go-redis/redis/v7"
+	log "github.com/sirupsen/logrus"
 	"gitlab.com/abyss.club/uexky/lib/postgres"
+	librd "gitlab.com/abyss.club/uexky/lib/redis"
 	"gitlab.com/abyss.club/uexky/lib/uid"
 	"gitlab.com/abyss.club/uexky/uexky/entity"
 )
 
-type NotiRepo struct{}
+type NotiRepo struct {
+	Redis *redis.Client
+}
 
 func (r *NotiRepo) GetUnreadCount(ctx context.Context, user *entity.User) (int, error) {
 	var count int
@@ -25,13 +31,25 @@ func (r *NotiRepo) GetUnreadCount(ctx context.Context, user *entity.User) (int,
 }
 
 func (r *NotiRepo) GetByKey(ctx context.Context, userID uid.UID, key string) (*entity.Notification, error) {
+	return r.getByKey(ctx, userID, key, false)
+}
+
+// LockByKey is GetByKey with the notification row locked until the transaction ends.
+func (r *NotiRepo) LockByKey(ctx context.Context, userID uid.UID, key string) (*entity.Notification, error) {
+	return r.getByKey(ctx, userID, key, true)
+}
+
+func (r *NotiRepo) getByKey(ctx context.Context, userID uid.UID, key string, lock bool) (*entity.Notification, error) {
 	var notification NotificationQuery
-	err := db(ctx).Model(&notification).
+	q := db(ctx).Model(&notification).
 		Column("notification.*").
 		ColumnExpr("u.last_read_noti >= sort_key as has_read").
 		Join(`LEFT JOIN public."user" as u ON u.id = ?`, userID).
-		Where("key = ?", key).Select()
-	if err != nil {
+		Where("key = ?", key)
+	if lock {
+		q = q.For("UPDATE OF notification")
+	}
+	if err := q.Select(); err != nil {
 		if err == pg.ErrNoRows {
 			return nil, nil
 		}
@@ -76,8 +94,28 @@ func (r *NotiRepo) Insert(ctx context.Context, noti *entity.Notification) error
 	if err != nil {
 		return err
 	}
-	_, err = db(ctx).Model(n).Insert()
-	return postgres.ErrHandlef(err, "InsertNoti(noti=%+v)", noti)
+	if _, err = db(ctx).Model(n).Insert(); err != nil {
+		return postgres.ErrHandlef(err, "InsertNoti(noti=%+v)", noti)
+	}
+	r.publish(ctx, noti)
+	return nil
+}
+
+// InsertIfAbsent inserts the notification, and returns false if one with the same key exists.
+func (r *NotiRepo) InsertIfAbsent(ctx context.Context, noti *entity.Notification) (bool, error) {
+	n, err := NewNotificaionFromEntity(noti)
+	if err != nil {
+		return false, err
+	}
+	res, err := db(ctx).Model(n).OnConflict("(key) DO NOTHING").Insert()
+	if err != nil {
+		return false, postgres.ErrHandlef(err, "InsertNotiIfAbsent(noti=%+v)", noti)
+	}
+	if res.RowsAffected() == 0 {
+		return false, nil
+	}
+	r.publish(ctx, noti)
+	return true, nil
 }
 
 func (r *NotiRepo) UpdateContent(ctx context.Context, noti *entity.Notification) error {
@@ -89,8 +127,13 @@ func (r *NotiRepo) UpdateContent(ctx context.Context, noti *entity.Notification)
 	_, err = db(ctx).Model(&notification).
 		Set("content = ?", content).
 		Set("sort_key = ?", noti.SortKey).
-		Where("key = ?", noti.Key).Update()
-	return postgres.ErrHandlef(err, "UpdateNotiContent(noti=%+v)", noti)
+		Where("key = ?", noti.Key).Returning("receivers").Update()
+	if err != nil {
+		return postgres.ErrHandlef(err, "UpdateNotiContent(noti=%+v)", noti)
+	}
+	noti.Receivers = notification.Receivers
+	r.publish(ctx, noti)
+	return nil
 }
 
 func (r *NotiRepo) UpdateReadID(ctx context.Context, user *entity.User, id uid.UID) error {
@@ -99,3 +142,55 @@ func (r *NotiRepo) UpdateReadID(ctx context.Context, user *entity.User, id uid.U
 		Set("last_read_noti = ?", id).Where("id = ?", user.ID).Update()
 	return postgres.ErrHandlef(err, "UpdateReadID(userID=%v, id=%v)", user.ID, id)
 }
+
+func notiChannel(receiver entity.Receiver) string {
+	return fmt.Sprintf("noti:%s", receiver)
+}
+
+// publish sends key of notification to channels of its receivers after transaction committed.
+func (r *NotiRepo) publish(ctx context.Context, noti *entity.Notification) {
+	key := noti.Key
+	receivers := noti.Receivers
+	postgres.AfterCommit(ctx, func() {
+		for _, receiver := range receivers {
+			channel := notiChannel(receiver)
+			if _, err := r.Redis.Publish(channel, key).Result(); err != nil {
+				log.Error(librd.ErrHandlef(err, "PublishNoti(channel=%s, key=%s)", channel, key))
+			}
+		}
+	})
+}
+
+func (r *NotiRepo) Subscribe(ctx context.Context, user *entity.User) (<-chan string, error) {
+	var channels []string
+	for _, receiver := range user.NotiReceivers() {
+		channels = append(channels, notiChannel(receiver))
+	}
+	pubsub := r.Redis.Subscribe(channels...)
+	if _, err := pubsub.Receive(); err != nil {
+		pubsub.Close()
+		return nil, librd.ErrHandlef(err, "SubscribeNoti(channels=%v)", channels)
+	}
+	keys := make(chan string)
+	go func() {
+		defer close(keys)
+		defer pubsub.Close()
+		msgs := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-msgs:
+				if !ok {
+					return
+				}
+				select {
+				case keys <- msg.Payload:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return keys, nil
+}
@@ -46,14 +46,30 @@ func (r *PostRepo) GetByID(ctx context.Context, id uid.UID) (*entity.Post, error
 	return post.ToEntity(), nil
 }
 
+func (r *PostRepo) GetByIDs(ctx context.Context, ids []uid.UID) ([]*entity.Post, error) {
+	var posts []Post
+	if err := db(ctx).Model(&posts).Where("id = ANY(?)", pg.Array(ids)).Select(); err != nil {
+		return nil, postgres.ErrHandlef(err, "GetPostsByIDs(ids=%v)", ids)
+	}
+	var entities []*entity.Post
+	for i := range posts {
+		entities = append(entities, (&posts[i]).ToEntity())
+	}
+	return entities, nil
+}
+
 func (r *PostRepo) Insert(ctx context.Context, post *entity.Post) (*entity.Post, error) {
 	log.Infof("InsertPost(%+v)", post)
 	p := NewPostFromEntity(post)
 	if _, err := db(ctx).Model(p).Returning("*").Insert(); err != nil {
 		return nil, postgres.ErrHandlef(err, "InsertPost.Insert(post=%+v)", post)
 	}
-	if _, err := db(ctx).Model(&Thread{}).Set("last_post_id=?", post.ID).
-		Where("id = ?", post.ThreadID).Update(); err != nil {
+	q := db(ctx).Model(&Thread{}).Set("last_post_id=?", post.ID).
+		Set("reply_count = reply_count + 1").
+		// log-sum-exp of current hot and score of the reply, see hotScore
+		Set("hot = greatest(hot, ?0) + ln(1 + exp(-least(abs(hot - ?0), 700)))", hotScore(post.CreatedAt)).
+		Where("id = ?", post.ThreadID)
+	if _, err := q.Update(); err != nil {
 		return nil, postgres.ErrHandlef(err, "InsertPost.UpdateThread(post=%+v)", post)
 	}
 	return p.ToEntity(), nil
@@ -67,6 +83,16 @@ func (r *PostRepo) Update(ctx context.Context, post *entity.Post) (*entity.Post,
 	return p.ToEntity(), postgres.ErrHandlef(err, "UpdatePost(post=%+v)", p)
 }
 
+// UpdateContent won't touch blocked post, whose content is masked in entity.
+func (r *PostRepo) UpdateContent(ctx context.Context, post *entity.Post) (*entity.Post, error) {
+	p := Post{}
+	_, err := db(ctx).Model(&p).Where("id = ?", post.ID).Where("NOT blocked").
+		Set("content = ?", post.Content).
+		Set("edited_at = ?", post.EditedAt).
+		Returning("*").Update()
+	return p.ToEntity(), postgres.ErrHandlef(err, "UpdatePostContent(post=%+v)", post)
+}
+
 func (r *PostRepo) QuotedPosts(ctx context.Context, post *entity.Post) ([]*entity.Post, error) {
 	var posts []Post
 	q := db(ctx).Model(&posts).Where("id = ANY(?)", pg.Array(post.QuoteIDs))
@@ -91,6 +117,26 @@ func (r *PostRepo) QuotedCount(ctx context.Context, post *entity.Post) (int, err
 	return count, postgres.ErrHandlef(err, "GetPostQuotedCount(id=%v)", post.ID)
 }
 
+func (r *PostRepo) QuotedCounts(ctx context.Context, ids []uid.UID) (map[uid.UID]int, error) {
+	var counts []struct {
+		ID    uid.UID
+		Count int
+	}
+	_, err := db(ctx).Query(&counts,
+		// filter with && first so that the GIN index on quoted_ids is used.
+		`SELECT q.id, count(DISTINCT post.id) FROM post, unnest(quoted_ids) AS q(id)
+		WHERE quoted_ids && ?0 AND q.id = ANY(?0) GROUP BY q.id`,
+		pg.Array(ids))
+	if err != nil {
+		return nil, postgres.ErrHandlef(err, "GetPostsQuotedCounts(ids=%v)", ids)
+	}
+	m := make(map[uid.UID]int, len(ids))
+	for _, c := range counts {
+		m[c.ID] = c.Count
+	}
+	return m, nil
+}
+
 func getPostSlice(ctx context.Context, qf queryFunc, sq *entity.SliceQuery, desc bool) (*entity.PostSlice, error) {
 	var posts []Post
 	var entities []*entity.Post
@@ -118,3 +164,52 @@ func getPostSlice(ctx context.Context, qf queryFunc, sq *entity.SliceQuery, desc
 		SliceInfo: sliceInfo,
 	}, nil
 }
+
+func newPostChannel(threadID uid.UID) string {
+	return fmt.Sprintf("new_post:%s", threadID.ToBase64String())
+}
+
+func (r *PostRepo) PublishNewPost(ctx context.Context, post *entity.Post) error {
+	channel := newPostChannel(post.ThreadID)
+	if _, err := r.Redis.Publish(channel, post.ID.ToBase64String()).Result(); err != nil {
+		return librd.ErrHandlef(err, "PublishNewPost(channel=%s, post=%v)", channel, post.ID)
+	}
+	return nil
+}
+
+// SubscribeNewPosts returns ids of new posts in thread, the channel is closed when ctx is done.
+func (r *PostRepo) SubscribeNewPosts(ctx context.Context, threadID uid.UID) (<-chan uid.UID, error) {
+	channel := newPostChannel(threadID)
+	pubsub := r.Redis.Subscribe(channel)
+	if _, err := pubsub.Receive(); err != nil {
+		pubsub.Close()
+		return nil, librd.ErrHandlef(err, "SubscribeNewPosts(channel=%s)", channel)
+	}
+	ids := make(chan uid.UID)
+	go func() {
+		defer close(ids)
+		defer pubsub.Close()
+		msgs := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-msgs:
+				if !ok {
+					return
+				}
+				id, err := uid.ParseUID(msg.Payload)
+				if err != nil {
+					log.Errorf("SubscribeNewPosts(channel=%s) parse payload %q: %v", channel, msg.Payload, err)
+					continue
+				}
+				select {
+				case ids <- id:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return ids, nil
+}
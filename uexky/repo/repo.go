@@ -11,11 +11,17 @@ import (
 
 func NewRepo(r *redis.Client) *entity.Repo {
 	return &entity.Repo{
-		User:   &UserRepo{Redis: r},
-		Thread: &ThreadRepo{Redis: r},
-		Post:   &PostRepo{Redis: r},
-		Tag:    &TagRepo{},
-		Noti:   &NotiRepo{},
+		User:     &UserRepo{Redis: r},
+		Thread:   &ThreadRepo{Redis: r},
+		Post:     &PostRepo{Redis: r},
+		Tag:      &TagRepo{Redis: r},
+		Noti:     &NotiRepo{Redis: r},
+		Search:   &SearchRepo{},
+		Revision: &RevisionRepo{},
+		Bookmark: &BookmarkRepo{},
+		Report:   &ReportRepo{},
+		ModLog:   &ModLogRepo{},
+		NetBan:   &NetBanRepo{},
 	}
 }
 
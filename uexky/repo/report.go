@@ -0,0 +1,71 @@
+package repo
+
+import (
+	"context"
+
+	"gitlab.com/abyss.club/uexky/lib/errors"
+	"gitlab.com/abyss.club/uexky/lib/postgres"
+	"gitlab.com/abyss.club/uexky/lib/uid"
+	"gitlab.com/abyss.club/uexky/uexky/entity"
+)
+
+type ReportRepo struct{}
+
+func (r *ReportRepo) Insert(ctx context.Context, report *entity.Report) error {
+	rp := NewReportFromEntity(report)
+	res, err := db(ctx).Model(rp).OnConflict("DO NOTHING").Insert()
+	if err != nil {
+		return postgres.ErrHandlef(err, "InsertReport(report=%+v)", report)
+	}
+	if res.RowsAffected() == 0 {
+		return errors.Duplicated.New("you have reported it")
+	}
+	return nil
+}
+
+func (r *ReportRepo) GetByID(ctx context.Context, id uid.UID) (*entity.Report, error) {
+	var report Report
+	if err := db(ctx).Model(&report).Where("id = ?", id).Select(); err != nil {
+		return nil, postgres.ErrHandlef(err, "GetReport(id=%v)", id)
+	}
+	return report.ToEntity(), nil
+}
+
+func (r *ReportRepo) Update(ctx context.Context, report *entity.Report) (*entity.Report, error) {
+	rp := NewReportFromEntity(report)
+	q := db(ctx).Model(rp).Where("id = ?", rp.ID).
+		Set("status = ?", rp.Status).
+		Set("handler_id = ?", rp.HandlerID).
+		Set("handled_at = ?", rp.HandledAt)
+	_, err := q.Returning("*").Update()
+	return rp.ToEntity(), postgres.ErrHandlef(err, "UpdateReport(report=%+v)", report)
+}
+
+func (r *ReportRepo) GetSlice(
+	ctx context.Context, status entity.ReportStatus, query entity.SliceQuery,
+) (*entity.ReportSlice, error) {
+	var reports []Report
+	var entities []*entity.Report
+	h := sliceHelper{
+		Column:      "id",
+		Desc:        true,
+		TransCursor: func(s string) (interface{}, error) { return uid.ParseUID(s) },
+		SQ:          &query,
+	}
+	q := db(ctx).Model(&reports).Where("status = ?", status)
+	if err := h.Select(q); err != nil {
+		return nil, postgres.ErrHandlef(err, "GetReportSlice(status=%v, query=%+v)", status, query)
+	}
+	h.DealResults(len(reports), func(i int) {
+		entities = append(entities, (&reports[i]).ToEntity())
+	})
+	sliceInfo := &entity.SliceInfo{HasNext: len(reports) > query.Limit}
+	if len(entities) > 0 {
+		sliceInfo.FirstCursor = entities[0].ID.ToBase64String()
+		sliceInfo.LastCursor = entities[len(entities)-1].ID.ToBase64String()
+	}
+	return &entity.ReportSlice{
+		Reports:   entities,
+		SliceInfo: sliceInfo,
+	}, nil
+}
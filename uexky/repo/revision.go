@@ -0,0 +1,29 @@
+package repo
+
+import (
+	"context"
+
+	"gitlab.com/abyss.club/uexky/lib/postgres"
+	"gitlab.com/abyss.club/uexky/lib/uid"
+	"gitlab.com/abyss.club/uexky/uexky/entity"
+)
+
+type RevisionRepo struct{}
+
+func (r *RevisionRepo) Insert(ctx context.Context, revision *entity.Revision) error {
+	rev := NewRevisionFromEntity(revision)
+	_, err := db(ctx).Model(rev).Insert()
+	return postgres.ErrHandlef(err, "InsertRevision(revision=%+v)", revision)
+}
+
+func (r *RevisionRepo) FindByTarget(ctx context.Context, targetID uid.UID) ([]*entity.Revision, error) {
+	var revisions []Revision
+	if err := db(ctx).Model(&revisions).Where("target_id = ?", targetID).Order("id DESC").Select(); err != nil {
+		return nil, postgres.ErrHandlef(err, "FindRevisions(targetID=%v)", targetID)
+	}
+	var entities []*entity.Revision
+	for i := range revisions {
+		entities = append(entities, (&revisions[i]).ToEntity())
+	}
+	return entities, nil
+}
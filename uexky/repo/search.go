@@ -0,0 +1,151 @@
+package repo
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-pg/pg/v9"
+	"github.com/go-pg/pg/v9/orm"
+	"gitlab.com/abyss.club/uexky/lib/errors"
+	"gitlab.com/abyss.club/uexky/lib/postgres"
+	"gitlab.com/abyss.club/uexky/lib/uid"
+	"gitlab.com/abyss.club/uexky/uexky/entity"
+)
+
+type SearchRepo struct{}
+
+// kinds of items in search results and bookmarks.
+const (
+	kindThread = "thread"
+	kindPost   = "post"
+)
+
+type searchResult struct {
+	//nolint: structcheck, unused
+	tableName struct{} `pg:"_,alias:search,discard_unknown_columns"`
+
+	Kind  string  `pg:"kind"`
+	ID    uid.UID `pg:"id"`
+	Score string  `pg:"score"` // numeric, keep it as string to avoid precision loss in cursor
+}
+
+func (r *searchResult) cursor() string {
+	return compositeCursor(r.Score, r.ID)
+}
+
+func parseSearchCursor(s string) (interface{}, error) {
+	return parseCompositeCursor(s, checkFloat)
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func likePattern(term string) string {
+	return "%" + likeEscaper.Replace(term) + "%"
+}
+
+// CheckCJK checks that pg_trgm extracts trigrams of CJK text, which requires a UTF-8 LC_CTYPE of database.
+// Otherwise word_similarity of CJK text is always 0, and the indexes are useless.
+func (r *SearchRepo) CheckCJK(ctx context.Context) error {
+	var count int
+	if _, err := db(ctx).Query(orm.Scan(&count), "SELECT cardinality(show_trgm('深渊'))"); err != nil {
+		return postgres.ErrHandle(err, "CheckCJK")
+	}
+	if count == 0 {
+		return errors.Internal.New("pg_trgm extracts no trigram from CJK text, LC_CTYPE of database must be UTF-8")
+	}
+	return nil
+}
+
+func (r *SearchRepo) Search(
+	ctx context.Context, search *entity.ContentSearch, query entity.SliceQuery,
+) (*entity.SearchSlice, error) {
+	threads := db(ctx).Model((*Thread)(nil)).
+		ColumnExpr("? AS kind, id", kindThread).
+		ColumnExpr("round(greatest(word_similarity(?0, coalesce(title, '')), word_similarity(?0, content))::numeric, 6) AS score",
+			search.Text).
+		Where("NOT blocked")
+	posts := db(ctx).Model((*Post)(nil)).
+		ColumnExpr("? AS kind, post.id", kindPost).
+		ColumnExpr("round(word_similarity(?, post.content)::numeric, 6) AS score", search.Text).
+		Join("JOIN thread AS t ON t.id = post.thread_id").
+		Where("NOT post.blocked").Where("NOT t.blocked")
+	for _, term := range search.Terms {
+		pattern := likePattern(term)
+		threads = threads.Where("(title ILIKE ?0 OR content ILIKE ?0)", pattern)
+		posts = posts.Where("post.content ILIKE ?", pattern)
+	}
+	if len(search.Tags) > 0 {
+		threads = threads.Where("tags && ?", pg.Array(search.Tags))
+		posts = posts.Where("t.tags && ?", pg.Array(search.Tags))
+	}
+	threads = excludeThreads(threads, search.ExcludedTags, search.HiddenBy, "thread")
+	posts = excludeThreads(posts, search.ExcludedTags, search.HiddenBy, "t")
+
+	var results []searchResult
+	h := sliceHelper{
+		Column:      "score, id",
+		Desc:        true,
+		TransCursor: parseSearchCursor,
+		SQ:          &query,
+	}
+	q := db(ctx).Model(&results).TableExpr("(?)", threads.UnionAll(posts))
+	if err := h.Select(q); err != nil {
+		return nil, postgres.ErrHandlef(err, "Search(search=%+v, query=%+v)", search, query)
+	}
+	var rsts []*searchResult
+	h.DealResults(len(results), func(i int) {
+		rsts = append(rsts, &results[i])
+	})
+
+	var threadIDs, postIDs []uid.UID
+	for _, r := range rsts {
+		if r.Kind == kindThread {
+			threadIDs = append(threadIDs, r.ID)
+		} else {
+			postIDs = append(postIDs, r.ID)
+		}
+	}
+	itemMap, err := loadThreadsAndPosts(ctx, threadIDs, postIDs)
+	if err != nil {
+		return nil, err
+	}
+	var items []entity.SearchItem
+	for _, r := range rsts {
+		if item, ok := itemMap[r.ID]; ok {
+			items = append(items, item.(entity.SearchItem))
+		}
+	}
+	sliceInfo := &entity.SliceInfo{HasNext: len(results) > query.Limit}
+	if len(rsts) > 0 {
+		sliceInfo.FirstCursor = rsts[0].cursor()
+		sliceInfo.LastCursor = rsts[len(rsts)-1].cursor()
+	}
+	return &entity.SearchSlice{
+		Items:     items,
+		SliceInfo: sliceInfo,
+	}, nil
+}
+
+// loadThreadsAndPosts returns map of id to *entity.Thread or *entity.Post.
+func loadThreadsAndPosts(ctx context.Context, threadIDs, postIDs []uid.UID) (map[uid.UID]interface{}, error) {
+	itemMap := map[uid.UID]interface{}{}
+	if len(threadIDs) > 0 {
+		var threads []Thread
+		if err := db(ctx).Model(&threads).Where("id = ANY(?)", pg.Array(threadIDs)).Select(); err != nil {
+			return nil, postgres.ErrHandlef(err, "GetThreadsByIDs(ids=%v)", threadIDs)
+		}
+		for i := range threads {
+			itemMap[threads[i].ID] = (&threads[i]).ToEntity()
+		}
+	}
+	if len(postIDs) > 0 {
+		var posts []Post
+		if err := db(ctx).Model(&posts).Where("id = ANY(?)", pg.Array(postIDs)).Select(); err != nil {
+			return nil, postgres.ErrHandlef(err, "GetPostsByIDs(ids=%v)", postIDs)
+		}
+		for i := range posts {
+			itemMap[posts[i].ID] = (&posts[i]).ToEntity()
+		}
+	}
+	return itemMap, nil
+}
@@ -2,12 +2,18 @@ package repo
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/go-pg/pg/v9"
 	"github.com/go-pg/pg/v9/orm"
 	"gitlab.com/abyss.club/uexky/lib/errors"
+	"gitlab.com/abyss.club/uexky/lib/uid"
 	"gitlab.com/abyss.club/uexky/uexky/entity"
 )
 
+// sliceHelper selects a slice by cursor. Column can be a list of columns like "score, id",
+// then TransCursor should return []interface{} to compare as row value.
 type sliceHelper struct {
 	Column      string
 	Desc        bool
@@ -40,13 +46,26 @@ func (h *sliceHelper) Select(q *orm.Query) error {
 		} else {
 			op = ">"
 		}
-		q = q.Where(fmt.Sprintf("%s %s ?", h.Column, op), value)
+		if values, ok := value.([]interface{}); ok {
+			q = q.Where(fmt.Sprintf("(%s) %s (?)", h.Column, op), pg.InMulti(values...))
+		} else {
+			q = q.Where(fmt.Sprintf("%s %s ?", h.Column, op), value)
+		}
 	}
-	order := h.Column
-	if (h.SQ.After != nil) == h.Desc {
-		order += " DESC"
+	var orders []string
+	for _, column := range strings.Split(h.Column, ",") {
+		order := strings.TrimSpace(column)
+		if (h.SQ.After != nil) == h.Desc {
+			order += " DESC"
+		}
+		orders = append(orders, order)
 	}
-	return q.Order(order).Limit(h.SQ.Limit + 1).Select()
+	if len(orders) > 1 {
+		q = q.OrderExpr(strings.Join(orders, ", "))
+	} else {
+		q = q.Order(orders[0])
+	}
+	return q.Limit(h.SQ.Limit + 1).Select()
 }
 
 func (h *sliceHelper) DealResults(length int, fn func(i int)) {
@@ -66,3 +85,35 @@ func (h *sliceHelper) DealResults(length int, fn func(i int)) {
 		fn(i)
 	}
 }
+
+// compositeCursor joins the sorting value and id as a cursor, like "12.5:AAAAAAAAAA".
+func compositeCursor(value interface{}, id uid.UID) string {
+	return fmt.Sprintf("%v:%s", value, id.ToBase64String())
+}
+
+// parseCompositeCursor returns the sorting value and id in cursor, the value is checked by checkValue
+// and kept in string, it's converted by postgres.
+func parseCompositeCursor(s string, checkValue func(string) error) ([]interface{}, error) {
+	i := strings.LastIndex(s, ":")
+	if i < 0 {
+		return nil, errors.BadParams.Errorf("invalid cursor: %s", s)
+	}
+	if err := checkValue(s[:i]); err != nil {
+		return nil, errors.BadParams.Handlef(err, "invalid cursor: %s", s)
+	}
+	id, err := uid.ParseUID(s[i+1:])
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{s[:i], id}, nil
+}
+
+func checkFloat(s string) error {
+	_, err := strconv.ParseFloat(s, 64)
+	return err
+}
+
+func checkInt(s string) error {
+	_, err := strconv.Atoi(s)
+	return err
+}
@@ -3,18 +3,34 @@ package repo
 import (
 	"context"
 	"fmt"
+	"strings"
 
-	"gitlab.com/abyss.club/uexky/lib/config"
+	"github.com/go-pg/pg/v9"
+	"github.com/go-pg/pg/v9/orm"
+	"github.com/go-redis/redis/v7"
+	log "github.com/sirupsen/logrus"
+	"gitlab.com/abyss.club/uexky/lib/algo"
+	"gitlab.com/abyss.club/uexky/lib/errors"
 	"gitlab.com/abyss.club/uexky/lib/postgres"
+	librd "gitlab.com/abyss.club/uexky/lib/redis"
 	"gitlab.com/abyss.club/uexky/uexky/entity"
 )
 
 type TagRepo struct {
+	Redis *redis.Client
 }
 
+const (
+	tagTypeMain = "main"
+	// threads keep the retired main tag, but new threads can't use it.
+	tagTypeRetired = "retired"
+)
+
+const tagsChannel = "tags"
+
 func (r *TagRepo) GetMainTags(ctx context.Context) ([]string, error) {
 	var tags []Tag
-	if err := db(ctx).Model(&tags).Where("type= ?", "main").Select(); err != nil {
+	if err := db(ctx).Model(&tags).Where("type= ?", tagTypeMain).Order("sort_order", "name").Select(); err != nil {
 		return nil, postgres.ErrHandle(err, "GetMainTags")
 	}
 	var mainTags []string
@@ -26,44 +42,312 @@ func (r *TagRepo) GetMainTags(ctx context.Context) ([]string, error) {
 
 func (r *TagRepo) SetMainTags(ctx context.Context, mainTags []string) error {
 	var tags []Tag
-	tagType := "main"
-	for _, t := range mainTags {
+	tagType := tagTypeMain
+	for i, t := range mainTags {
 		tags = append(tags, Tag{
-			Name:    t,
-			TagType: &tagType,
+			Name:      t,
+			TagType:   &tagType,
+			SortOrder: i,
 		})
 	}
 	if _, err := db(ctx).Model(&tags).Insert(); err != nil {
 		return postgres.ErrHandlef(err, "SetMainTags(tags=%v)", tags)
 	}
+	return postgres.ErrHandlef(addTagStat(ctx, mainTags), "SetMainTags(tags=%v)", tags)
+}
+
+// addTagStat creates stats of tags without threads, such as new main tags, so that they can be searched.
+func addTagStat(ctx context.Context, names []string) error {
+	if _, err := db(ctx).Exec("INSERT INTO tag_stat (name) SELECT unnest(?::text[]) ON CONFLICT (name) DO NOTHING",
+		pg.Array(names)); err != nil {
+		return err
+	}
+	_, err := indexTagPinyin(ctx, names)
+	return err
+}
+
+// checkNotAlias rejects names merged into other tags, they would be replaced by the canonical ones on input.
+func checkNotAlias(ctx context.Context, name string) error {
+	var alias TagAlias
+	err := db(ctx).Model(&alias).Where("alias = ?", name).Select()
+	if errors.Is(err, pg.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return postgres.ErrHandlef(err, "CheckNotAlias(name=%s)", name)
+	}
+	return errors.BadParams.Errorf("%s is an alias of %s", name, alias.Tag)
+}
+
+// AddMainTag adds main tag to the end, a retired main tag is restored.
+func (r *TagRepo) AddMainTag(ctx context.Context, name string) error {
+	if err := checkNotAlias(ctx, name); err != nil {
+		return err
+	}
+	res, err := db(ctx).Exec(`INSERT INTO tag (name, type, sort_order)
+		VALUES (?0, ?1, (SELECT coalesce(max(sort_order), -1) + 1 FROM tag WHERE type = ?1))
+		ON CONFLICT (name) DO UPDATE SET type = excluded.type, sort_order = excluded.sort_order
+		WHERE tag.type = ?2`, name, tagTypeMain, tagTypeRetired)
+	if err != nil {
+		return postgres.ErrHandlef(err, "AddMainTag(name=%s)", name)
+	}
+	if res.RowsAffected() == 0 {
+		return errors.Duplicated.Errorf("%s is already a main tag", name)
+	}
+	return postgres.ErrHandlef(addTagStat(ctx, []string{name}), "AddMainTag(name=%s)", name)
+}
+
+// RenameMainTag renames main tag in tags of threads, subscribed tags and tag roles of users.
+// If a thread has the new name as sub tag, it's merged into main tag.
+func (r *TagRepo) RenameMainTag(ctx context.Context, name, newName string) error {
+	if err := checkNotAlias(ctx, newName); err != nil {
+		return err
+	}
+	exists, err := db(ctx).Model((*Tag)(nil)).Where("name = ?", newName).Exists()
+	if err != nil {
+		return postgres.ErrHandlef(err, "RenameMainTag(name=%s, newName=%s)", name, newName)
+	}
+	if exists {
+		return errors.Duplicated.Errorf("%s is already a main tag or retired", newName)
+	}
+	res, err := db(ctx).Model((*Tag)(nil)).Set("name = ?", newName).
+		Where("name = ?", name).Where("type = ?", tagTypeMain).Update()
+	if err != nil {
+		return postgres.ErrHandlef(err, "RenameMainTag(name=%s, newName=%s)", name, newName)
+	}
+	if res.RowsAffected() == 0 {
+		return errors.NotFound.Errorf("%s is not a main tag", name)
+	}
+	if err := replaceTag(ctx, name, newName); err != nil {
+		return postgres.ErrHandlef(err, "RenameMainTag(name=%s, newName=%s)", name, newName)
+	}
+	if err := addTagStat(ctx, []string{newName}); err != nil {
+		return postgres.ErrHandlef(err, "RenameMainTag(name=%s, newName=%s)", name, newName)
+	}
+	if _, err := db(ctx).Exec(`UPDATE "user" SET tag_roles = (
+			SELECT jsonb_agg(CASE WHEN tr->>'tag' = ?0 THEN jsonb_set(tr, '{tag}', to_jsonb(?1::text)) ELSE tr END)
+			FROM jsonb_array_elements(tag_roles) AS tr
+		) WHERE tag_roles @> jsonb_build_array(jsonb_build_object('tag', ?0::text))`, name, newName); err != nil {
+		return postgres.ErrHandlef(err, "RenameMainTag(name=%s, newName=%s)", name, newName)
+	}
 	return nil
 }
 
-func (r *TagRepo) Search(ctx context.Context, search *entity.TagSearch) ([]*entity.Tag, error) {
-	type tag struct {
-		Tag string `pg:"tag"`
+// replaceTag replaces tag in threads, subscribed and muted tags of users, keeps position of the replaced one.
+func replaceTag(ctx context.Context, name, newName string) error {
+	columns := []struct{ table, column string }{
+		{"thread", "tags"},
+		{`"user"`, "tags"},
+		{`"user"`, "muted_tags"},
+	}
+	for _, c := range columns {
+		if _, err := db(ctx).Exec(fmt.Sprintf(
+			"UPDATE %[1]s SET %[2]s = array_replace(array_remove(%[2]s, ?1), ?0, ?1) WHERE %[2]s @> ?2", c.table, c.column,
+		), name, newName, pg.Array([]string{name})); err != nil {
+			return err
+		}
+	}
+	_, err := indexTagPinyin(ctx, []string{newName})
+	return err
+}
+
+func (r *TagRepo) RetireMainTag(ctx context.Context, name string) error {
+	res, err := db(ctx).Model((*Tag)(nil)).Set("type = ?", tagTypeRetired).
+		Where("name = ?", name).Where("type = ?", tagTypeMain).Update()
+	if err != nil {
+		return postgres.ErrHandlef(err, "RetireMainTag(name=%s)", name)
+	}
+	if res.RowsAffected() == 0 {
+		return errors.NotFound.Errorf("%s is not a main tag", name)
+	}
+	return nil
+}
+
+// ReorderMainTags sorts main tags in order of tags, which must contain all of them.
+func (r *TagRepo) ReorderMainTags(ctx context.Context, tags []string) error {
+	_, err := db(ctx).Model((*Tag)(nil)).Set("sort_order = array_position(?, name)", pg.Array(tags)).
+		Where("type = ?", tagTypeMain).Update()
+	return postgres.ErrHandlef(err, "ReorderMainTags(tags=%v)", tags)
+}
+
+func (r *TagRepo) GetAliases(ctx context.Context) (map[string]string, error) {
+	var aliases []TagAlias
+	if err := db(ctx).Model(&aliases).Select(); err != nil {
+		return nil, postgres.ErrHandle(err, "GetAliases")
+	}
+	aliasMap := make(map[string]string, len(aliases))
+	for _, a := range aliases {
+		aliasMap[a.Alias] = a.Tag
+	}
+	return aliasMap, nil
+}
+
+// MergeTags makes from an alias of into, and replaces from with into in threads and subscribed tags of users.
+// Aliases of from are moved to into as well.
+func (r *TagRepo) MergeTags(ctx context.Context, from, into string) error {
+	if _, err := db(ctx).Model((*TagAlias)(nil)).Where("alias = ?", into).Delete(); err != nil {
+		return postgres.ErrHandlef(err, "MergeTags(from=%s, into=%s)", from, into)
+	}
+	if _, err := db(ctx).Model((*TagAlias)(nil)).Set("tag = ?", into).Where("tag = ?", from).Update(); err != nil {
+		return postgres.ErrHandlef(err, "MergeTags(from=%s, into=%s)", from, into)
+	}
+	alias := &TagAlias{Alias: from, Tag: into}
+	if _, err := db(ctx).Model(alias).OnConflict("(alias) DO UPDATE").Set("tag = EXCLUDED.tag").Insert(); err != nil {
+		return postgres.ErrHandlef(err, "MergeTags(from=%s, into=%s)", from, into)
+	}
+	return postgres.ErrHandlef(replaceTag(ctx, from, into), "MergeTags(from=%s, into=%s)", from, into)
+}
+
+// PublishTagsChanged notifies all instances to reload main tags and aliases after transaction committed.
+func (r *TagRepo) PublishTagsChanged(ctx context.Context) {
+	postgres.AfterCommit(ctx, func() {
+		if _, err := r.Redis.Publish(tagsChannel, "").Result(); err != nil {
+			log.Error(librd.ErrHandlef(err, "PublishTagsChanged()"))
+		}
+	})
+}
+
+func (r *TagRepo) SubscribeTagsChanged(ctx context.Context) (<-chan struct{}, error) {
+	pubsub := r.Redis.Subscribe(tagsChannel)
+	if _, err := pubsub.Receive(); err != nil {
+		pubsub.Close()
+		return nil, librd.ErrHandlef(err, "SubscribeTagsChanged()")
+	}
+	changes := make(chan struct{})
+	go func() {
+		defer close(changes)
+		defer pubsub.Close()
+		msgs := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case _, ok := <-msgs:
+				if !ok {
+					return
+				}
+				select {
+				case changes <- struct{}{}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return changes, nil
+}
+
+func (r *TagRepo) GetByName(ctx context.Context, name string) (*entity.Tag, error) {
+	var stats []TagStat
+	if err := db(ctx).Model(&stats).Where("name = ?", name).Select(); err != nil {
+		return nil, postgres.ErrHandlef(err, "GetTagByName(name=%s)", name)
+	}
+	infos, err := getTagInfos(ctx, []string{name})
+	if err != nil {
+		return nil, err
+	}
+	if len(stats) == 0 {
+		if infos[name] == nil {
+			return nil, errors.NotFound.Errorf("tag %s not found", name)
+		}
+		stats = append(stats, TagStat{Name: name}) // main tag without threads
+	}
+	return stats[0].ToEntity(infos[name]), nil
+}
+
+func getTagInfos(ctx context.Context, names []string) (map[string]*Tag, error) {
+	var tags []Tag
+	if err := db(ctx).Model(&tags).Where("name = ANY(?)", pg.Array(names)).Select(); err != nil {
+		return nil, postgres.ErrHandlef(err, "getTagInfos(names=%v)", names)
+	}
+	infos := map[string]*Tag{}
+	for i := range tags {
+		infos[tags[i].Name] = &tags[i]
+	}
+	return infos, nil
+}
+
+func (r *TagRepo) UpdateInfo(ctx context.Context, name string, description, rules *string) error {
+	res, err := db(ctx).Model((*Tag)(nil)).Set("description = ?", description).Set("rules = ?", rules).
+		Where("name = ?", name).Update()
+	if err != nil {
+		return postgres.ErrHandlef(err, "UpdateTagInfo(name=%s)", name)
+	}
+	if res.RowsAffected() == 0 {
+		return errors.NotFound.Errorf("%s is not a main tag", name)
 	}
-	var tags []tag
-	var where, limit string
-	if search.Text != "" {
-		where = fmt.Sprintf("WHERE tag LIKE '%%%s%%'", search.Text)
+	return nil
+}
+
+// tag search ranks exact matches first, then prefix matches, then substring matches.
+const (
+	tagMatchExact = iota
+	tagMatchPrefix
+	tagMatchSubstring
+)
+
+func (r *TagRepo) Search(ctx context.Context, search *entity.TagSearch) ([]*entity.Tag, error) {
+	var stats []TagStat
+	// tags of deleted threads and unsubscribed are left out, main tags are always searchable.
+	q := db(ctx).Model(&stats).WhereGroup(func(q *orm.Query) (*orm.Query, error) {
+		return q.Where("thread_count > 0").WhereOr("subscriber_count > 0").
+			WhereOr("name IN (SELECT name FROM tag WHERE type = ?)", tagTypeMain), nil
+	})
+	if text := strings.ToLower(search.Text); text != "" {
+		escaped := likeEscaper.Replace(text)
+		prefix, substring := escaped+"%", "%"+escaped+"%"
+		q = q.Column("tag_stat.*").ColumnExpr(`CASE
+			WHEN lower(name) = ?0 OR pinyin = ?0 OR initials = ?0 THEN ?3
+			WHEN lower(name) LIKE ?1 OR pinyin LIKE ?1 OR initials LIKE ?1 THEN ?4
+			ELSE ?5 END AS rank`, text, prefix, substring, tagMatchExact, tagMatchPrefix, tagMatchSubstring).
+			Where("(lower(name) LIKE ?0 OR pinyin LIKE ?0 OR initials LIKE ?1)", substring, prefix).
+			Order("rank")
 	}
+	q = q.OrderExpr("active_at DESC NULLS LAST").Order("name")
 	if search.Limit != 0 {
-		limit = fmt.Sprintf("LIMIT %v", search.Limit)
+		q = q.Limit(search.Limit)
 	}
-	sql := fmt.Sprintf(`SELECT tag FROM (
-		SELECT unnest(tags) as tag, max(created_at) as updated_at
-		FROM thread group by tag
-	) as tags %s ORDER BY updated_at DESC %s`, where, limit)
-	if _, err := db(ctx).Query(&tags, sql); err != nil {
-		return nil, postgres.ErrHandlef(err, "GetTags(search=%+v)", search)
+	if err := q.Select(); err != nil {
+		return nil, postgres.ErrHandlef(err, "SearchTags(search=%+v)", search)
 	}
-	var entities []*entity.Tag
-	for _, t := range tags {
-		entities = append(entities, &entity.Tag{
-			Name:   t.Tag,
-			IsMain: config.IsMainTag(t.Tag),
-		})
+	var names []string
+	for _, s := range stats {
+		names = append(names, s.Name)
+	}
+	infos, err := getTagInfos(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+	entities := []*entity.Tag{}
+	for i := range stats {
+		entities = append(entities, stats[i].ToEntity(infos[stats[i].Name]))
 	}
 	return entities, nil
 }
+
+// indexTagPinyin fills pinyin of tags not indexed yet in tag_stat, all of them if names is nil.
+// Rows of tag_stat are created by trigger of thread table, which can't compute pinyin.
+func indexTagPinyin(ctx context.Context, names []string) (int, error) {
+	var stats []TagStat
+	q := db(ctx).Model(&stats).Column("name").Where("pinyin IS NULL")
+	if names != nil {
+		q = q.Where("name = ANY(?)", pg.Array(names))
+	}
+	if err := q.Select(); err != nil {
+		return 0, postgres.ErrHandlef(err, "indexTagPinyin(names=%v)", names)
+	}
+	if len(stats) == 0 {
+		return 0, nil
+	}
+	for i := range stats {
+		full, initials := algo.Pinyin(stats[i].Name)
+		stats[i].Pinyin, stats[i].Initials = &full, &initials
+	}
+	_, err := db(ctx).Model(&stats).Column("pinyin", "initials").Update()
+	return len(stats), postgres.ErrHandlef(err, "indexTagPinyin(names=%v)", names)
+}
+
+// IndexPinyin fills pinyin of all tags not indexed yet, returns the count of them.
+func (r *TagRepo) IndexPinyin(ctx context.Context) (int, error) {
+	return indexTagPinyin(ctx, nil)
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"crypto/sha256"
 	"fmt"
+	"math"
 	"math/rand"
+	"strconv"
+	"time"
 
 	"github.com/go-pg/pg/v9"
 	"github.com/go-pg/pg/v9/orm"
@@ -51,19 +54,72 @@ func (r *ThreadRepo) GetByID(ctx context.Context, id uid.UID) (*entity.Thread, e
 func (r *ThreadRepo) FindSlice(
 	ctx context.Context, params *entity.ThreadsSearch, query entity.SliceQuery,
 ) (*entity.ThreadSlice, error) {
+	filter := func(q *orm.Query) *orm.Query {
+		q = excludeThreads(q, params.ExcludedTags, params.HiddenBy, "thread")
+		if params.SkipBlocked {
+			q = q.Where("NOT blocked")
+		}
+		return q
+	}
 	qf := func(prev *orm.Query) *orm.Query {
-		return prev.Where("id IN (SELECT id FROM thread WHERE ? && thread.tags)", pg.Array(params.Tags))
+		q := filter(prev).Where("id IN (SELECT id FROM thread WHERE ? && thread.tags)", pg.Array(params.Tags))
+		if params.SkipPinned {
+			return q
+		}
+		return q.Where("NOT ("+pinnedInTags+")", pg.Array(params.Tags))
+	}
+	// pinned threads are out of the cursor, only returned on the first page in the places of others.
+	// At least one place is left to others, so that the cursor of slice moves forward.
+	var pinned []Thread
+	if !params.SkipPinned && query.After != nil && *query.After == "" && query.Limit > 1 {
+		q := db(ctx).Model(&pinned).Where(pinnedInTags, pg.Array(params.Tags)).
+			Order("id DESC").Limit(query.Limit - 1)
+		if err := filter(q).Select(); err != nil {
+			return nil, postgres.ErrHandlef(err, "FindPinnedThreads(tags=%v)", params.Tags)
+		}
+		query.Limit -= len(pinned)
+	}
+	slice, err := getThreadSlice(ctx, qf, params.Sort, &query)
+	if err != nil {
+		return nil, err
+	}
+	if len(pinned) > 0 {
+		threads := make([]*entity.Thread, 0, len(pinned)+len(slice.Threads))
+		for i := range pinned {
+			threads = append(threads, (&pinned[i]).ToEntity())
+		}
+		slice.Threads = append(threads, slice.Threads...)
+	}
+	return slice, nil
+}
+
+// excludeThreads leaves out threads with any of tags or hidden by user, alias is the thread table in query.
+// They are only filters, so cursors of slices keep working.
+func excludeThreads(q *orm.Query, tags []string, hiddenBy *uid.UID, alias string) *orm.Query {
+	if len(tags) > 0 {
+		q = q.Where("NOT (?.tags && ?)", pg.Ident(alias), pg.Array(tags))
+	}
+	if hiddenBy != nil {
+		q = q.Where("NOT EXISTS (SELECT 1 FROM hidden_thread AS h WHERE h.thread_id = ?.id AND h.user_id = ?)",
+			pg.Ident(alias), *hiddenBy)
 	}
-	return getThreadSlice(ctx, qf, &query)
+	return q
 }
 
+// pinnedInTags matches threads pinned in their main tag, which is in the tags.
+const pinnedInTags = "pinned AND (pinned_until IS NULL OR pinned_until > now()) AND tags[1] = ANY(?)"
+
 func (r *ThreadRepo) Insert(ctx context.Context, thread *entity.Thread) (*entity.Thread, error) {
 	log.Infof("InsertThread(%v)", thread)
 	t := NewThreadFromEntity(thread)
 	t.LastPostID = t.ID
+	t.Hot = hotScore(t.CreatedAt)
 	if _, err := db(ctx).Model(t).Returning("*").Insert(); err != nil {
 		return nil, postgres.ErrHandlef(err, "InsertThread(thread=%+v)", thread)
 	}
+	if _, err := indexTagPinyin(ctx, t.Tags); err != nil {
+		return nil, err
+	}
 	return t.ToEntity(), nil
 }
 
@@ -72,9 +128,27 @@ func (r *ThreadRepo) Update(ctx context.Context, thread *entity.Thread) (*entity
 	q := db(ctx).Model(t).Where("id = ?", t.ID).
 		Set("tags = ?", pg.Array(t.Tags)).
 		Set("blocked = ?", t.Blocked).
-		Set("locked = ?", t.Locked)
-	_, err := q.Returning("*").Update()
-	return t.ToEntity(), postgres.ErrHandlef(err, "UpdateThread(thread=%+v)", t)
+		Set("locked = ?", t.Locked).
+		Set("pinned = ?", t.Pinned).
+		Set("pinned_until = ?", t.PinnedUntil)
+	if _, err := q.Returning("*").Update(); err != nil {
+		return nil, postgres.ErrHandlef(err, "UpdateThread(thread=%+v)", t)
+	}
+	if _, err := indexTagPinyin(ctx, t.Tags); err != nil {
+		return nil, err
+	}
+	return t.ToEntity(), nil
+}
+
+// UpdateContent won't touch blocked thread, whose content is masked in entity.
+func (r *ThreadRepo) UpdateContent(ctx context.Context, thread *entity.Thread) (*entity.Thread, error) {
+	t := NewThreadFromEntity(thread)
+	_, err := db(ctx).Model(t).Where("id = ?", t.ID).Where("NOT blocked").
+		Set("title = ?", t.Title).
+		Set("content = ?", t.Content).
+		Set("edited_at = ?", t.EditedAt).
+		Returning("*").Update()
+	return t.ToEntity(), postgres.ErrHandlef(err, "UpdateThreadContent(thread=%+v)", t)
 }
 
 func (r *ThreadRepo) Replies(ctx context.Context, thread *entity.Thread, sq entity.SliceQuery) (*entity.PostSlice, error) {
@@ -91,6 +165,23 @@ func (r *ThreadRepo) ReplyCount(ctx context.Context, thread *entity.Thread) (int
 	return count, postgres.ErrHandle(err, "GetThreadReplyCount")
 }
 
+func (r *ThreadRepo) ReplyCounts(ctx context.Context, ids []uid.UID) (map[uid.UID]int, error) {
+	var counts []struct {
+		ThreadID uid.UID
+		Count    int
+	}
+	_, err := db(ctx).Query(&counts,
+		"SELECT thread_id, count(*) FROM post WHERE thread_id = ANY(?) GROUP BY thread_id", pg.Array(ids))
+	if err != nil {
+		return nil, postgres.ErrHandlef(err, "GetThreadReplyCounts(ids=%v)", ids)
+	}
+	m := make(map[uid.UID]int, len(ids))
+	for _, c := range counts {
+		m[c.ThreadID] = c.Count
+	}
+	return m, nil
+}
+
 func (r *ThreadRepo) Catalog(ctx context.Context, thread *entity.Thread) ([]*entity.ThreadCatalogItem, error) {
 	var posts []Post
 	q := db(ctx).Model(&posts).Column("id", "created_at").Where("thread_id=?", thread.ID).Order("id")
@@ -107,43 +198,161 @@ func (r *ThreadRepo) Catalog(ctx context.Context, thread *entity.Thread) ([]*ent
 	return cats, nil
 }
 
-func (r *ThreadRepo) PostAID(ctx context.Context, thread *entity.Thread, user *entity.User) (string, error) {
-	var posts []Post
-	q := db(ctx).Model(&posts).Column("author").
-		Where("thread_id = ?", thread.ID).Where("anonymous = true").Order("id DESC").Limit(1)
-	if err := q.Select(); err != nil {
-		return "", postgres.ErrHandlef(err, "GetAnonyID(userID=%v, threadID=%v", user.ID, thread.ID)
-	}
-	if len(posts) > 0 {
-		return posts[0].Author, nil
-	}
-	return uid.NewUID().ToBase64String(), nil
+func (r *ThreadRepo) Watch(ctx context.Context, threadID uid.UID, userID uid.UID) error {
+	w := &ThreadWatch{ThreadID: threadID, UserID: userID}
+	_, err := db(ctx).Model(w).OnConflict("DO NOTHING").Insert()
+	return postgres.ErrHandlef(err, "WatchThread(threadID=%v, userID=%v)", threadID, userID)
+}
+
+func (r *ThreadRepo) Unwatch(ctx context.Context, threadID uid.UID, userID uid.UID) error {
+	_, err := db(ctx).Model((*ThreadWatch)(nil)).
+		Where("thread_id = ?", threadID).Where("user_id = ?", userID).Delete()
+	return postgres.ErrHandlef(err, "UnwatchThread(threadID=%v, userID=%v)", threadID, userID)
+}
+
+func (r *ThreadRepo) IsWatched(ctx context.Context, threadID uid.UID, userID uid.UID) (bool, error) {
+	exists, err := db(ctx).Model((*ThreadWatch)(nil)).
+		Where("thread_id = ?", threadID).Where("user_id = ?", userID).Exists()
+	return exists, postgres.ErrHandlef(err, "IsThreadWatched(threadID=%v, userID=%v)", threadID, userID)
+}
+
+func (r *ThreadRepo) Watchers(ctx context.Context, threadID uid.UID) ([]uid.UID, error) {
+	var watchers []uid.UID
+	err := db(ctx).Model((*ThreadWatch)(nil)).Column("user_id").Where("thread_id = ?", threadID).Select(&watchers)
+	return watchers, postgres.ErrHandlef(err, "GetThreadWatchers(threadID=%v)", threadID)
 }
 
-func getThreadSlice(ctx context.Context, qf queryFunc, sq *entity.SliceQuery) (*entity.ThreadSlice, error) {
+// hotHalfLife is the time for hot score of a thread to decay to half.
+const hotHalfLife = 24 * time.Hour
+
+// hotScore is log of the weight of an event happened at t, weight doubles every hotHalfLife.
+// The hot score of thread is log of sum of weights of all events (publishing and replies), so that
+// comparing scores is same as comparing decayed weights at any moment, and it won't overflow.
+func hotScore(t time.Time) float64 {
+	return float64(t.Unix()) / hotHalfLife.Seconds() * math.Ln2
+}
+
+type threadOrder struct {
+	column      string
+	transCursor func(s string) (interface{}, error)
+	cursor      func(t *Thread) string
+}
+
+func parseUIDCursor(s string) (interface{}, error) {
+	return uid.ParseUID(s)
+}
+
+var threadOrders = map[entity.ThreadSort]threadOrder{
+	entity.ThreadSortBump: {
+		column:      "last_post_id",
+		transCursor: parseUIDCursor,
+		cursor:      func(t *Thread) string { return t.LastPostID.ToBase64String() },
+	},
+	entity.ThreadSortNewest: {
+		column:      "id",
+		transCursor: parseUIDCursor,
+		cursor:      func(t *Thread) string { return t.ID.ToBase64String() },
+	},
+	entity.ThreadSortReplies: {
+		column: "reply_count, id",
+		transCursor: func(s string) (interface{}, error) {
+			return parseCompositeCursor(s, checkInt)
+		},
+		cursor: func(t *Thread) string { return compositeCursor(t.ReplyCount, t.ID) },
+	},
+	entity.ThreadSortHot: {
+		column: "hot, id",
+		transCursor: func(s string) (interface{}, error) {
+			return parseCompositeCursor(s, checkFloat)
+		},
+		cursor: func(t *Thread) string {
+			return compositeCursor(strconv.FormatFloat(t.Hot, 'g', -1, 64), t.ID)
+		},
+	},
+}
+
+func getThreadSlice(
+	ctx context.Context, qf queryFunc, sort entity.ThreadSort, sq *entity.SliceQuery,
+) (*entity.ThreadSlice, error) {
+	order, ok := threadOrders[sort]
+	if !ok {
+		return nil, errors.BadParams.Errorf("invalid sort: %s", sort)
+	}
 	var threads []Thread
 	var entities []*entity.Thread
 	h := sliceHelper{
-		Column: "last_post_id",
-		Desc:   true,
-		TransCursor: func(s string) (interface{}, error) {
-			return uid.ParseUID(s)
-		},
-		SQ: sq,
+		Column:      order.column,
+		Desc:        true,
+		TransCursor: order.transCursor,
+		SQ:          sq,
 	}
 	if err := h.Select(qf(db(ctx).Model(&threads))); err != nil {
 		return nil, postgres.ErrHandle(err, "GetThreadSlice")
 	}
+	var cursors []string
 	h.DealResults(len(threads), func(i int) {
 		entities = append(entities, (&threads[i]).ToEntity())
+		cursors = append(cursors, order.cursor(&threads[i]))
 	})
 	sliceInfo := &entity.SliceInfo{HasNext: len(threads) > sq.Limit}
 	if len(entities) > 0 {
-		sliceInfo.FirstCursor = entities[0].LastPostID.ToBase64String()
-		sliceInfo.LastCursor = entities[len(entities)-1].LastPostID.ToBase64String()
+		sliceInfo.FirstCursor = cursors[0]
+		sliceInfo.LastCursor = cursors[len(cursors)-1]
 	}
 	return &entity.ThreadSlice{
 		Threads:   entities,
 		SliceInfo: sliceInfo,
 	}, nil
 }
+
+func (r *ThreadRepo) Hide(ctx context.Context, threadID uid.UID, userID uid.UID) error {
+	h := &HiddenThread{ThreadID: threadID, UserID: userID, SortKey: uid.NewUID()}
+	_, err := db(ctx).Model(h).OnConflict("DO NOTHING").Insert()
+	return postgres.ErrHandlef(err, "HideThread(threadID=%v, userID=%v)", threadID, userID)
+}
+
+func (r *ThreadRepo) Unhide(ctx context.Context, threadID uid.UID, userID uid.UID) error {
+	_, err := db(ctx).Model((*HiddenThread)(nil)).
+		Where("thread_id = ?", threadID).Where("user_id = ?", userID).Delete()
+	return postgres.ErrHandlef(err, "UnhideThread(threadID=%v, userID=%v)", threadID, userID)
+}
+
+// HiddenSlice returns threads hidden by user, latest hidden first.
+func (r *ThreadRepo) HiddenSlice(ctx context.Context, userID uid.UID, query entity.SliceQuery) (*entity.ThreadSlice, error) {
+	var hidden []HiddenThread
+	h := sliceHelper{
+		Column:      "sort_key",
+		Desc:        true,
+		TransCursor: func(s string) (interface{}, error) { return uid.ParseUID(s) },
+		SQ:          &query,
+	}
+	q := db(ctx).Model(&hidden).Where("user_id = ?", userID)
+	if err := h.Select(q); err != nil {
+		return nil, postgres.ErrHandlef(err, "GetHiddenThreadSlice(userID=%v, query=%+v)", userID, query)
+	}
+	var hs []*HiddenThread
+	var threadIDs []uid.UID
+	h.DealResults(len(hidden), func(i int) {
+		hs = append(hs, &hidden[i])
+		threadIDs = append(threadIDs, hidden[i].ThreadID)
+	})
+	itemMap, err := loadThreadsAndPosts(ctx, threadIDs, nil)
+	if err != nil {
+		return nil, err
+	}
+	threads := []*entity.Thread{}
+	for _, h := range hs {
+		if thread, ok := itemMap[h.ThreadID]; ok {
+			threads = append(threads, thread.(*entity.Thread))
+		}
+	}
+	sliceInfo := &entity.SliceInfo{HasNext: len(hidden) > query.Limit}
+	if len(hs) > 0 {
+		sliceInfo.FirstCursor = hs[0].SortKey.ToBase64String()
+		sliceInfo.LastCursor = hs[len(hs)-1].SortKey.ToBase64String()
+	}
+	return &entity.ThreadSlice{
+		Threads:   threads,
+		SliceInfo: sliceInfo,
+	}, nil
+}
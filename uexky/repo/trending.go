@@ -0,0 +1,147 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-pg/pg/v9"
+	"github.com/go-redis/redis/v7"
+	"gitlab.com/abyss.club/uexky/lib/postgres"
+	librd "gitlab.com/abyss.club/uexky/lib/redis"
+	"gitlab.com/abyss.club/uexky/uexky/entity"
+)
+
+// trending data is kept a while in case refreshing stops, it's replaced on every refresh.
+const trendingExpire = 24 * time.Hour
+
+const trendingLockKey = "trending_tags:lock"
+
+func trendingKey(window entity.TrendingWindow) string {
+	return fmt.Sprintf("trending_tags:%s", window)
+}
+
+func cooccurrenceKey(window entity.TrendingWindow) string {
+	return fmt.Sprintf("tag_cooccurrence:%s", window)
+}
+
+// tags can't contain NUL in postgres, so it's safe to join a pair of tags with it.
+func tagPairField(tag, candidate string) string {
+	return tag + "\x00" + candidate
+}
+
+// trendingActivity weights each thread active since ?0 by new thread and count of replies.
+const trendingActivity = `WITH activity AS (
+	SELECT t.tags, (t.created_at > ?0)::int + coalesce(p.count, 0) AS weight
+	FROM thread AS t LEFT JOIN (
+		SELECT thread_id, count(*) FROM post WHERE created_at > ?0 AND NOT blocked GROUP BY thread_id
+	) AS p ON p.thread_id = t.id
+	WHERE NOT t.blocked AND (t.created_at > ?0 OR p.count > 0)
+) `
+
+type tagPairScore struct {
+	Tag       string  `pg:"tag"`
+	Candidate string  `pg:"candidate"`
+	Score     float64 `pg:"score"`
+}
+
+func (r *TagRepo) RefreshTrending(ctx context.Context, window entity.TrendingWindow) error {
+	since := time.Now().Add(-window.Duration())
+	var scores []tagPairScore
+	// tags[2:] are sub tags, the first one is main tag.
+	if _, err := db(ctx).Query(&scores, trendingActivity+`
+		SELECT tag, sum(weight) AS score FROM activity, unnest(tags[2:]) AS tag
+		GROUP BY tag ORDER BY score DESC, tag LIMIT ?1`, since, entity.TrendingTagsLimit,
+	); err != nil {
+		return postgres.ErrHandlef(err, "RefreshTrending(window=%s)", window)
+	}
+	var pairs []tagPairScore
+	if len(scores) > 0 {
+		var candidates []string
+		for _, s := range scores {
+			candidates = append(candidates, s.Tag)
+		}
+		if _, err := db(ctx).Query(&pairs, trendingActivity+`
+			SELECT tag, candidate, sum(weight) AS score
+			FROM activity, unnest(tags) AS tag, unnest(tags[2:]) AS candidate
+			WHERE tag <> candidate AND candidate = ANY(?1) GROUP BY tag, candidate`, since, pg.Array(candidates),
+		); err != nil {
+			return postgres.ErrHandlef(err, "RefreshTrending(window=%s)", window)
+		}
+	}
+
+	key, coKey := trendingKey(window), cooccurrenceKey(window)
+	_, err := r.Redis.TxPipelined(func(pipe redis.Pipeliner) error {
+		pipe.Del(key, coKey)
+		if len(scores) == 0 {
+			return nil
+		}
+		var members []*redis.Z
+		for _, s := range scores {
+			members = append(members, &redis.Z{Score: s.Score, Member: s.Tag})
+		}
+		pipe.ZAdd(key, members...)
+		pipe.Expire(key, trendingExpire)
+		if len(pairs) > 0 {
+			fields := map[string]interface{}{}
+			for _, p := range pairs {
+				fields[tagPairField(p.Tag, p.Candidate)] = p.Score
+			}
+			pipe.HSet(coKey, fields)
+			pipe.Expire(coKey, trendingExpire)
+		}
+		return nil
+	})
+	return librd.ErrHandlef(err, "RefreshTrending(window=%s)", window)
+}
+
+func (r *TagRepo) GetTrending(ctx context.Context, window entity.TrendingWindow, limit int) ([]entity.TagScore, error) {
+	zs, err := r.Redis.ZRevRangeWithScores(trendingKey(window), 0, int64(limit-1)).Result()
+	if err != nil {
+		return nil, librd.ErrHandlef(err, "GetTrending(window=%s, limit=%v)", window, limit)
+	}
+	var scores []entity.TagScore
+	for _, z := range zs {
+		name, _ := z.Member.(string)
+		scores = append(scores, entity.TagScore{Name: name, Score: z.Score})
+	}
+	return scores, nil
+}
+
+func (r *TagRepo) GetCooccurrence(
+	ctx context.Context, window entity.TrendingWindow, tags, candidates []string,
+) (map[string]float64, error) {
+	cooccurrence := map[string]float64{}
+	if len(tags) == 0 || len(candidates) == 0 {
+		return cooccurrence, nil
+	}
+	var fields []string
+	for _, t := range tags {
+		for _, c := range candidates {
+			fields = append(fields, tagPairField(t, c))
+		}
+	}
+	values, err := r.Redis.HMGet(cooccurrenceKey(window), fields...).Result()
+	if err != nil {
+		return nil, librd.ErrHandlef(err, "GetCooccurrence(window=%s, tags=%v)", window, tags)
+	}
+	for i, v := range values {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		score, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			continue
+		}
+		cooccurrence[candidates[i%len(candidates)]] += score
+	}
+	return cooccurrence, nil
+}
+
+// LockTrendingRefresh returns true if the lock is acquired, so that only one instance refreshes in ttl.
+func (r *TagRepo) LockTrendingRefresh(ctx context.Context, ttl time.Duration) (bool, error) {
+	ok, err := r.Redis.SetNX(trendingLockKey, 1, ttl).Result()
+	return ok, librd.ErrHandlef(err, "LockTrendingRefresh(ttl=%v)", ttl)
+}
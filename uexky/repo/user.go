@@ -41,7 +41,10 @@ func (u *UserRepo) GetGuestByID(ctx context.Context, id uid.UID) (*entity.User,
 	if err := json.Unmarshal([]byte(data), &user); err != nil {
 		return nil, errors.Internal.Handlef(err, "unmarshal user: %s", data)
 	}
-	return user.ToEntity(), nil
+	// guests are stored in redis, merging tags can't rewrite their tags.
+	e := user.ToEntity()
+	e.CanonicalizeTags()
+	return e, nil
 }
 
 func (u *UserRepo) GetByID(ctx context.Context, id uid.UID) (*entity.User, error) {
@@ -61,6 +64,22 @@ func (u *UserRepo) GetByID(ctx context.Context, id uid.UID) (*entity.User, error
 	return rUser.ToEntity(), nil
 }
 
+func (u *UserRepo) GetStaff(ctx context.Context) ([]*entity.User, error) {
+	var users []User
+	err := db(ctx).Model(&users).
+		WhereOr("role IN (?)", pg.In([]entity.Role{entity.RoleAdmin, entity.RoleMod})).
+		WhereOr("tag_roles @> '[{}]'"). // has any granted role
+		Order("id").Select()
+	if err != nil {
+		return nil, postgres.ErrHandlef(err, "GetStaff()")
+	}
+	var entities []*entity.User
+	for i := range users {
+		entities = append(entities, (&users[i]).ToEntity())
+	}
+	return entities, nil
+}
+
 func (u *UserRepo) Insert(ctx context.Context, user *entity.User) (*entity.User, error) {
 	rUser := NewUserFromEntity(user)
 	if user.Role == entity.RoleGuest {
@@ -84,7 +103,7 @@ func (u *UserRepo) Insert(ctx context.Context, user *entity.User) (*entity.User,
 
 func (u *UserRepo) Update(ctx context.Context, user *entity.User) (*entity.User, error) {
 	rUser := NewUserFromEntity(user)
-	if user.Role == entity.RoleGuest {
+	if user.Email == nil { // guest, maybe banned, is stored in redis
 		data, err := json.Marshal(&rUser)
 		if err != nil {
 			return nil, errors.BadParams.Handlef(err, "UpdateUser(user=%+v)", user)
@@ -99,11 +118,21 @@ func (u *UserRepo) Update(ctx context.Context, user *entity.User) (*entity.User,
 		Set("role = ?", rUser.Role).
 		Set("tags = ?", pg.Array(rUser.Tags)).
 		Set("last_read_noti = ?", rUser.LastReadNoti).
+		Set("auto_watch = ?", rUser.AutoWatch).
+		Set("prev_role = ?", rUser.PrevRole).
+		Set("ban_reason = ?", rUser.BanReason).
+		Set("ban_expires_at = ?", rUser.BanExpiresAt).
+		Set("tag_roles = ?", rUser.TagRoles).
+		Set("muted_tags = ?", pg.Array(rUser.MutedTags)).
 		Returning("*")
 	_, err := q.Update()
 	if err != nil {
 		return nil, errors.Wrapf(err, "UpdateUser(user=%+v)", user)
 	}
+	// subscribed tags without threads are searchable as well.
+	if _, err := indexTagPinyin(ctx, rUser.Tags); err != nil {
+		return nil, err
+	}
 	return rUser.ToEntity(), nil
 }
 
@@ -111,7 +140,7 @@ func (u *UserRepo) ThreadSlice(ctx context.Context, user *entity.User, sq entity
 	qf := func(prev *orm.Query) *orm.Query {
 		return prev.Where("user_id = ?", user.ID)
 	}
-	return getThreadSlice(ctx, qf, &sq)
+	return getThreadSlice(ctx, qf, entity.ThreadSortBump, &sq)
 }
 
 func (u *UserRepo) PostSlice(ctx context.Context, user *entity.User, sq entity.SliceQuery) (*entity.PostSlice, error) {
@@ -2,6 +2,9 @@ package uexky
 
 import (
 	"context"
+	"net"
+	"sort"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -20,13 +23,13 @@ type Service struct {
 
 func NewService(tx adapter.Tx, repo *entity.Repo) (*Service, error) {
 	s := &Service{TxAdapter: tx, Repo: repo}
-	if err := loadMainTags(s); err != nil {
+	if err := loadTags(s); err != nil {
 		return nil, err
 	}
 	return s, nil
 }
 
-func loadMainTags(s *Service) error {
+func loadTags(s *Service) error {
 	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(10*time.Second))
 	defer cancel()
 	ctx = s.TxAdapter.AttachDB(ctx)
@@ -34,7 +37,12 @@ func loadMainTags(s *Service) error {
 	if err != nil {
 		return errors.Wrap(err, "get main tags from db")
 	}
+	aliases, err := s.Repo.Tag.GetAliases(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get tag aliases from db")
+	}
 	config.SetMainTags(mainTags)
+	config.SetTagAliases(aliases)
 	return nil
 }
 
@@ -49,6 +57,10 @@ func (s *Service) AttachEmailUserToCtx(ctx context.Context, email string) (conte
 
 		// new signed in user
 		user = entity.NewSignedInUser(email)
+		// charged by client ip or remote address, the new user can't be used to identify the client.
+		if err := MutCost(ctx, config.Get().RateLimit.Cost.CreateUser); err != nil {
+			return nil, err
+		}
 		user, err = s.Repo.User.Insert(ctx, user)
 		if err != nil {
 			return nil, errors.Wrap(err, "Create New User")
@@ -57,6 +69,9 @@ func (s *Service) AttachEmailUserToCtx(ctx context.Context, email string) (conte
 			log.Error(err, "NewNotiOnNewUser")
 		}
 	}
+	if user, err = s.liftExpiredBan(ctx, user); err != nil {
+		return nil, err
+	}
 	return user.AttachContext(ctx), nil
 }
 
@@ -67,14 +82,33 @@ func (s *Service) AttachGuestUserToCtx(ctx context.Context, id uid.UID) (context
 			return nil, errors.Wrap(err, "User.GetByID")
 		}
 		user = entity.NewGuestUser(id)
+		if err := MutCost(ctx, config.Get().RateLimit.Cost.CreateUser); err != nil {
+			return nil, err
+		}
 		user, err = s.Repo.User.Insert(ctx, user)
 		if err != nil {
 			return nil, errors.Wrap(err, "Create New Guest User")
 		}
 	}
+	if user, err = s.liftExpiredBan(ctx, user); err != nil {
+		return nil, err
+	}
 	return user.AttachContext(ctx), nil
 }
 
+// liftExpiredBan reinstates the user whose ban has expired. It's checked when the user is attached to context,
+// so there is no need of a scheduled job.
+func (s *Service) liftExpiredBan(ctx context.Context, user *entity.User) (*entity.User, error) {
+	if !user.BanExpired() {
+		return user, nil
+	}
+	if err := user.Unban(); err != nil {
+		return nil, err
+	}
+	user, err := s.Repo.User.Update(ctx, user)
+	return user, errors.Wrap(err, "User.Update")
+}
+
 func (s *Service) Profile(ctx context.Context) (*entity.User, error) {
 	if err := Cost(ctx, 1); err != nil {
 		return nil, err
@@ -87,7 +121,7 @@ func (s *Service) Profile(ctx context.Context) (*entity.User, error) {
 }
 
 func (s *Service) SetUserName(ctx context.Context, name string) (*entity.User, error) {
-	if err := Cost(ctx, 1); err != nil {
+	if err := MutCost(ctx, 1); err != nil {
 		return nil, err
 	}
 	user := entity.GetCurrentUser(ctx)
@@ -100,6 +134,19 @@ func (s *Service) SetUserName(ctx context.Context, name string) (*entity.User, e
 	return s.Repo.User.Update(ctx, user)
 }
 
+// SetAutoWatch toggles whether current user watches the threads replied to automatically.
+func (s *Service) SetAutoWatch(ctx context.Context, enable bool) (*entity.User, error) {
+	if err := MutCost(ctx, 1); err != nil {
+		return nil, err
+	}
+	user := entity.GetCurrentUser(ctx)
+	if err := user.RequirePermission(entity.ActionWatchThread); err != nil {
+		return nil, err
+	}
+	user.AutoWatch = enable
+	return s.Repo.User.Update(ctx, user)
+}
+
 func (s *Service) GetUserThreads(
 	ctx context.Context, obj *entity.User, query entity.SliceQuery,
 ) (*entity.ThreadSlice, error) {
@@ -131,7 +178,7 @@ func (s *Service) GetUserPosts(ctx context.Context, obj *entity.User, query enti
 }
 
 func (s *Service) SyncUserTags(ctx context.Context, tags []string) (*entity.User, error) {
-	if err := Cost(ctx, 1); err != nil {
+	if err := MutCost(ctx, 1); err != nil {
 		return nil, err
 	}
 	user := entity.GetCurrentUser(ctx)
@@ -143,7 +190,7 @@ func (s *Service) SyncUserTags(ctx context.Context, tags []string) (*entity.User
 }
 
 func (s *Service) AddUserSubbedTag(ctx context.Context, tag string) (*entity.User, error) {
-	if err := Cost(ctx, 1); err != nil {
+	if err := MutCost(ctx, 1); err != nil {
 		return nil, err
 	}
 	user := entity.GetCurrentUser(ctx)
@@ -155,7 +202,7 @@ func (s *Service) AddUserSubbedTag(ctx context.Context, tag string) (*entity.Use
 }
 
 func (s *Service) DelUserSubbedTag(ctx context.Context, tag string) (*entity.User, error) {
-	if err := Cost(ctx, 1); err != nil {
+	if err := MutCost(ctx, 1); err != nil {
 		return nil, err
 	}
 	user := entity.GetCurrentUser(ctx)
@@ -166,247 +213,1208 @@ func (s *Service) DelUserSubbedTag(ctx context.Context, tag string) (*entity.Use
 	return s.Repo.User.Update(ctx, user)
 }
 
-func (s *Service) BanUser(ctx context.Context, postID *uid.UID, threadID *uid.UID) (bool, error) {
+func (s *Service) MuteTag(ctx context.Context, tag string) (*entity.User, error) {
+	if err := MutCost(ctx, 1); err != nil {
+		return nil, err
+	}
 	user := entity.GetCurrentUser(ctx)
-	if err := user.RequirePermission(entity.ActionBanUser); err != nil {
+	if err := user.RequirePermission(entity.ActionProfile); err != nil {
+		return nil, err
+	}
+	if err := user.MuteTag(tag); err != nil {
+		return nil, err
+	}
+	return s.Repo.User.Update(ctx, user)
+}
+
+func (s *Service) UnmuteTag(ctx context.Context, tag string) (*entity.User, error) {
+	if err := MutCost(ctx, 1); err != nil {
+		return nil, err
+	}
+	user := entity.GetCurrentUser(ctx)
+	if err := user.RequirePermission(entity.ActionProfile); err != nil {
+		return nil, err
+	}
+	user.UnmuteTag(tag)
+	return s.Repo.User.Update(ctx, user)
+}
+
+func (s *Service) GetUserHiddenThreads(
+	ctx context.Context, obj *entity.User, query entity.SliceQuery,
+) (*entity.ThreadSlice, error) {
+	if err := Cost(ctx, query.Limit); err != nil {
+		return nil, err
+	}
+	user := entity.GetCurrentUser(ctx)
+	if err := user.RequirePermission(entity.ActionProfile); err != nil {
+		return nil, err
+	}
+	if obj == nil || obj.ID != user.ID {
+		return nil, errors.Permission.New("permission denied")
+	}
+	return s.Repo.Thread.HiddenSlice(ctx, user.ID, query)
+}
+
+// BanUser bans the author of post or thread for duration hours, or forever if duration is nil.
+func (s *Service) BanUser(
+	ctx context.Context, postID *uid.UID, threadID *uid.UID, reason *string, duration *int,
+) (bool, error) {
+	if err := MutCost(ctx, 1); err != nil {
 		return false, err
 	}
-	var targetID uid.UID
+	author, err := s.findAuthor(ctx, postID, threadID)
+	if err != nil {
+		return false, err
+	}
+	return s.banAuthor(ctx, author, reason, duration)
+}
+
+func (s *Service) banAuthor(ctx context.Context, author *entity.Author, reason *string, duration *int) (bool, error) {
+	expiresAt, err := banExpiry(duration)
+	if err != nil {
+		return false, err
+	}
+	return s.moderateAuthor(ctx, author, entity.ModActionBanUser, reason, func(user *entity.User) error {
+		return user.Ban(reason, expiresAt)
+	})
+}
+
+// UnbanUser restores the role of user before banned.
+func (s *Service) UnbanUser(ctx context.Context, postID *uid.UID, threadID *uid.UID, reason *string) (bool, error) {
+	if err := MutCost(ctx, 1); err != nil {
+		return false, err
+	}
+	author, err := s.findAuthor(ctx, postID, threadID)
+	if err != nil {
+		return false, err
+	}
+	return s.moderateAuthor(ctx, author, entity.ModActionUnbanUser, reason, func(user *entity.User) error {
+		return user.Unban()
+	})
+}
+
+// SetUserRole sets role of the user found by email or id, or the role granted in main tag if tag is not nil.
+func (s *Service) SetUserRole(
+	ctx context.Context, email *string, userID *uid.UID, role entity.Role, tag *string, reason *string,
+) (*entity.User, error) {
+	if err := MutCost(ctx, 1); err != nil {
+		return nil, err
+	}
+	user := entity.GetCurrentUser(ctx)
+	if err := user.RequirePermission(entity.ActionPromoteUser); err != nil {
+		return nil, err
+	}
+	var target *entity.User
+	err := s.TxAdapter.WithTx(ctx, func() error {
+		var err error
+		switch {
+		case email != nil:
+			target, err = s.Repo.User.GetByEmail(ctx, *email)
+		case userID != nil:
+			target, err = s.Repo.User.GetByID(ctx, *userID)
+		default:
+			return errors.BadParams.New("must specified email or user id")
+		}
+		if err != nil {
+			return err
+		}
+		if target.ID == user.ID && tag == nil && role != target.Role {
+			return errors.BadParams.New("can not change role of yourself")
+		}
+		before := target.ModState()
+		if err := target.SetRole(role, algo.NullToString(tag)); err != nil {
+			return err
+		}
+		if target, err = s.Repo.User.Update(ctx, target); err != nil {
+			return err
+		}
+		return s.modLog(ctx, user, entity.ModActionSetUserRole, target.ID, reason, before, target.ModState())
+	})
+	return target, err
+}
+
+// banExpiry returns the expiry of ban lasting duration hours, nil for forever.
+func banExpiry(duration *int) (*time.Time, error) {
+	if duration == nil {
+		return nil, nil
+	}
+	if *duration <= 0 {
+		return nil, errors.BadParams.New("ban duration must be positive")
+	}
+	t := time.Now().Add(time.Duration(*duration) * time.Hour)
+	return &t, nil
+}
+
+func (s *Service) findAuthor(ctx context.Context, postID *uid.UID, threadID *uid.UID) (*entity.Author, error) {
 	switch {
 	case postID != nil:
 		post, err := s.Repo.Post.GetByID(ctx, *postID)
 		if err != nil {
-			return false, err
+			return nil, err
 		}
-		targetID = post.Author.UserID
+		return post.Author, nil
 	case threadID != nil:
 		thread, err := s.Repo.Thread.GetByID(ctx, *threadID)
 		if err != nil {
-			return false, err
+			return nil, err
 		}
-		targetID = thread.Author.UserID
+		return thread.Author, nil
 	default:
-		return false, errors.BadParams.New("must specified post id or thread id")
+		return nil, errors.BadParams.New("must specified post id or thread id")
 	}
-	target, err := s.Repo.User.GetByID(ctx, targetID)
-	if err != nil {
-		if errors.Is(err, errors.NotFound) {
-			return false, nil
-		}
-		return false, errors.Wrap(err, "User.GetByID")
+}
+
+// moderateAuthor applies the moderation action to the user of author and records it in mod log in one transaction,
+// returns false if the user is not found, such as expired guests.
+func (s *Service) moderateAuthor(
+	ctx context.Context, author *entity.Author, action entity.ModAction, reason *string,
+	fn func(user *entity.User) error,
+) (bool, error) {
+	user := entity.GetCurrentUser(ctx)
+	if err := user.RequirePermission(modActionPermission[action]); err != nil {
+		return false, err
 	}
-	target.Ban()
-	if _, err := s.Repo.User.Update(ctx, target); err != nil {
-		return false, errors.Wrap(err, "User.Update")
+	found := false
+	err := s.TxAdapter.WithTx(ctx, func() error {
+		target, err := s.Repo.User.GetByID(ctx, author.UserID)
+		if err != nil {
+			if errors.Is(err, errors.NotFound) {
+				return nil
+			}
+			return errors.Wrap(err, "User.GetByID")
+		}
+		before := target.ModState()
+		if err := fn(target); err != nil {
+			return err
+		}
+		if _, err := s.Repo.User.Update(ctx, target); err != nil {
+			return errors.Wrap(err, "User.Update")
+		}
+		found = true
+		if action == entity.ModActionBanUser {
+			noti, err := entity.NewBanNoti(target)
+			if err != nil {
+				return err
+			}
+			if err := s.Repo.Noti.Insert(ctx, noti); err != nil {
+				return err
+			}
+		}
+		return s.modLog(ctx, user, action, target.ID, reason, before, target.ModState())
+	})
+	return found, err
+}
+
+// modLog records the moderation action, it should be in the same transaction of the action.
+func (s *Service) modLog(
+	ctx context.Context, actor *entity.User, action entity.ModAction, targetID uid.UID,
+	reason *string, before, after interface{},
+) error {
+	l, err := entity.NewModLog(actor, action, targetID, reason, before, after)
+	if err != nil {
+		return err
 	}
-	return true, nil
+	return s.Repo.ModLog.Insert(ctx, l)
 }
 
 // ---- Thread Part ----
 
 func (s *Service) PubThread(ctx context.Context, thread entity.ThreadInput) (*entity.Thread, error) {
-	if err := Cost(ctx, 1); err != nil {
+	if err := MutCost(ctx, config.Get().RateLimit.Cost.PubThread); err != nil {
 		return nil, err
 	}
-	err := s.Repo.Thread.CheckIfDuplicated(ctx, thread.Title, thread.Content)
-	if err != nil {
-		return nil, errors.Wrap(err, "Thread.CheckIfDuplicated")
-	}
 	var newThread *entity.Thread
-	err = s.TxAdapter.WithTx(ctx, func() error {
+	err := s.TxAdapter.WithTx(ctx, func() error {
 		user := entity.GetCurrentUser(ctx)
 		if err := user.RequirePermission(entity.ActionPubThread); err != nil {
 			return errors.Wrapf(err, "PubThread(thread=%+v)", thread)
 		}
+		if err := s.checkPubThread(ctx, user, thread.Title, thread.Content); err != nil {
+			return err
+		}
 		t, err := entity.NewThread(user, thread)
 		if err != nil {
 			return err
 		}
+		t.Author.IP = getClientIP(ctx)
 		t, err = s.Repo.Thread.Insert(ctx, t)
 		if err != nil {
 			return errors.Wrapf(err, "PubThread(thread=%+v)", thread)
 		}
+		// authors always watch their threads.
+		if user.RequirePermission(entity.ActionWatchThread) == nil {
+			if err := s.Repo.Thread.Watch(ctx, t.ID, user.ID); err != nil {
+				return err
+			}
+		}
 		newThread = t
 		return nil
 	})
 	return newThread, err
 }
 
-func (s *Service) LockThread(ctx context.Context, threadID uid.UID) (*entity.Thread, error) {
-	if err := Cost(ctx, 1); err != nil {
+// checkPubThread runs the checks of publishing thread, which edits of thread pass as well.
+func (s *Service) checkPubThread(ctx context.Context, user *entity.User, title *string, content string) error {
+	if err := s.checkNetBan(ctx, user); err != nil {
+		return err
+	}
+	if err := s.Repo.Thread.CheckIfDuplicated(ctx, title, content); err != nil {
+		return errors.Wrap(err, "Thread.CheckIfDuplicated")
+	}
+	return nil
+}
+
+// EditThread changes title and content of thread by its author, the previous version is kept as revision.
+func (s *Service) EditThread(
+	ctx context.Context, threadID uid.UID, title *string, content string,
+) (*entity.Thread, error) {
+	if err := MutCost(ctx, config.Get().RateLimit.Cost.PubThread); err != nil {
+		return nil, err
+	}
+	user := entity.GetCurrentUser(ctx)
+	if err := user.RequirePermission(entity.ActionPubThread); err != nil {
+		return nil, err
+	}
+	var thread *entity.Thread
+	err := s.TxAdapter.WithTx(ctx, func() error {
+		var err error
+		thread, err = s.Repo.Thread.GetByID(ctx, threadID)
+		if err != nil {
+			return err
+		}
+		rev, err := thread.Edit(user, title, content)
+		if err != nil {
+			return err
+		}
+		if err := s.checkPubThread(ctx, user, title, content); err != nil {
+			return err
+		}
+		if err := s.Repo.Revision.Insert(ctx, rev); err != nil {
+			return err
+		}
+		thread, err = s.Repo.Thread.UpdateContent(ctx, thread)
+		return err
+	})
+	return thread, err
+}
+
+// WatchThread makes current user receive replied notifications of the thread.
+func (s *Service) WatchThread(ctx context.Context, threadID uid.UID) (*entity.Thread, error) {
+	if err := MutCost(ctx, 1); err != nil {
 		return nil, err
 	}
 	user := entity.GetCurrentUser(ctx)
-	if err := user.RequirePermission(entity.ActionLockThread); err != nil {
+	if err := user.RequirePermission(entity.ActionWatchThread); err != nil {
 		return nil, err
 	}
 	thread, err := s.Repo.Thread.GetByID(ctx, threadID)
 	if err != nil {
 		return nil, err
 	}
-	thread.Lock()
-	return s.Repo.Thread.Update(ctx, thread)
+	if err := s.Repo.Thread.Watch(ctx, thread.ID, user.ID); err != nil {
+		return nil, err
+	}
+	return thread, nil
 }
 
-func (s *Service) BlockThread(ctx context.Context, threadID uid.UID) (*entity.Thread, error) {
-	if err := Cost(ctx, 1); err != nil {
+func (s *Service) UnwatchThread(ctx context.Context, threadID uid.UID) (*entity.Thread, error) {
+	if err := MutCost(ctx, 1); err != nil {
 		return nil, err
 	}
 	user := entity.GetCurrentUser(ctx)
-	if err := user.RequirePermission(entity.ActionBlockThread); err != nil {
+	if err := user.RequirePermission(entity.ActionWatchThread); err != nil {
 		return nil, err
 	}
 	thread, err := s.Repo.Thread.GetByID(ctx, threadID)
 	if err != nil {
 		return nil, err
 	}
-	thread.Block()
-	return s.Repo.Thread.Update(ctx, thread)
+	if err := s.Repo.Thread.Unwatch(ctx, thread.ID, user.ID); err != nil {
+		return nil, err
+	}
+	return thread, nil
 }
 
-func (s *Service) EditTags(
-	ctx context.Context, threadID uid.UID, mainTag string, subTags []string,
-) (*entity.Thread, error) {
-	if err := Cost(ctx, 1); err != nil {
+// HideThread leaves the thread out from thread slices, timeline and search of current user.
+func (s *Service) HideThread(ctx context.Context, threadID uid.UID) (*entity.Thread, error) {
+	if err := MutCost(ctx, 1); err != nil {
 		return nil, err
 	}
 	user := entity.GetCurrentUser(ctx)
-	if err := user.RequirePermission(entity.ActionEditTag); err != nil {
+	if err := user.RequirePermission(entity.ActionProfile); err != nil {
 		return nil, err
 	}
 	thread, err := s.Repo.Thread.GetByID(ctx, threadID)
 	if err != nil {
 		return nil, err
 	}
-	if err := thread.EditTags(mainTag, subTags); err != nil {
+	if err := s.Repo.Thread.Hide(ctx, thread.ID, user.ID); err != nil {
 		return nil, err
 	}
-	return s.Repo.Thread.Update(ctx, thread)
+	return thread, nil
 }
 
-func (s *Service) SearchThreads(
-	ctx context.Context, tags []string, query entity.SliceQuery,
-) (*entity.ThreadSlice, error) {
-	if err := Cost(ctx, query.Limit); err != nil {
+func (s *Service) UnhideThread(ctx context.Context, threadID uid.UID) (*entity.Thread, error) {
+	if err := MutCost(ctx, 1); err != nil {
 		return nil, err
 	}
-	return s.Repo.Thread.FindSlice(ctx, &entity.ThreadsSearch{Tags: tags}, query)
-}
-
-func (s *Service) GetThreadByID(ctx context.Context, id uid.UID) (*entity.Thread, error) {
-	if err := Cost(ctx, 1); err != nil {
+	user := entity.GetCurrentUser(ctx)
+	if err := user.RequirePermission(entity.ActionProfile); err != nil {
 		return nil, err
 	}
-	return s.Repo.Thread.GetByID(ctx, id)
-}
-
-func (s *Service) GetThreadReplies(ctx context.Context, thread *entity.Thread, query entity.SliceQuery) (*entity.PostSlice, error) {
-	if err := Cost(ctx, query.Limit); err != nil {
+	thread, err := s.Repo.Thread.GetByID(ctx, threadID)
+	if err != nil {
 		return nil, err
 	}
-	postSlice, err := s.Repo.Thread.Replies(ctx, thread, query)
-	if err != nil {
-		return nil, errors.Wrap(err, "Thread.Replies")
+	if err := s.Repo.Thread.Unhide(ctx, thread.ID, user.ID); err != nil {
+		return nil, err
 	}
-	return postSlice, err
-}
-
-func (s *Service) GetThreadReplyCount(ctx context.Context, thread *entity.Thread) (int, error) {
-	count, err := s.Repo.Thread.ReplyCount(ctx, thread)
-	return count, errors.Wrap(err, "Thread.ReplyCount")
+	return thread, nil
 }
 
-func (s *Service) GetThreadCatalog(ctx context.Context, thread *entity.Thread) ([]*entity.ThreadCatalogItem, error) {
-	catalogs, err := s.Repo.Thread.Catalog(ctx, thread)
-	return catalogs, errors.Wrap(err, "Thread.Catalog")
-}
-
-// ---- Post Part ----
-
-func (s *Service) PubPost(ctx context.Context, input entity.PostInput) (*entity.Post, error) {
-	if err := Cost(ctx, 1); err != nil {
+func (s *Service) LockThread(ctx context.Context, threadID uid.UID, reason *string) (*entity.Thread, error) {
+	if err := MutCost(ctx, 1); err != nil {
 		return nil, err
 	}
+	return s.moderateThread(ctx, threadID, entity.ModActionLockThread, reason, func(thread *entity.Thread) error {
+		thread.Lock()
+		return nil
+	})
+}
+
+// moderateThread applies the moderation action to thread and records it in mod log in one transaction.
+// Roles granted in the main tag of thread are counted in permission check.
+func (s *Service) moderateThread(
+	ctx context.Context, threadID uid.UID, action entity.ModAction, reason *string,
+	fn func(thread *entity.Thread) error,
+) (*entity.Thread, error) {
 	user := entity.GetCurrentUser(ctx)
-	if err := user.RequirePermission(entity.ActionPubPost); err != nil {
-		return nil, errors.Wrapf(err, "PubPost(input=%+v)", input)
-	}
-	if err := s.Repo.Post.CheckIfDuplicated(ctx, user.ID, input.Content); err != nil {
-		return nil, errors.Wrap(err, "Thread.CheckIfDuplicated")
-	}
-	var post *entity.Post
 	var thread *entity.Thread
 	err := s.TxAdapter.WithTx(ctx, func() error {
 		var err error
-		thread, err = s.Repo.Thread.GetByID(ctx, input.ThreadID)
+		thread, err = s.Repo.Thread.GetByID(ctx, threadID)
 		if err != nil {
-			return errors.Wrap(err, "find thread")
-		}
-		var aid string
-		if input.Anonymous {
-			aid, err = s.Repo.Thread.PostAID(ctx, thread, user)
-			if err != nil {
-				return errors.Wrap(err, "Thread.PostAID")
-			}
+			return err
 		}
-		post, err = entity.NewPost(&input, user, thread, aid)
-		if err != nil {
-			return errors.Wrap(err, "NewPost")
+		if err := user.RequireTagPermission(modActionPermission[action], thread.MainTag); err != nil {
+			return err
 		}
-		post, err = s.Repo.Post.Insert(ctx, post)
-		if err != nil {
-			return errors.Wrapf(err, "PubPost(input=%+v)", input)
+		before := thread.ModState()
+		if err := fn(thread); err != nil {
+			return err
 		}
-		quotedPost, err := s.Repo.Post.QuotedPosts(ctx, post)
+		thread, err = s.Repo.Thread.Update(ctx, thread)
 		if err != nil {
 			return err
 		}
-		s.NewNotiOnNewPost(ctx, user, thread, post, quotedPost)
-		return nil
+		return s.modLog(ctx, user, action, thread.ID, reason, before, thread.ModState())
 	})
-	if err != nil {
+	return thread, err
+}
+
+// modActionPermission is the permission required by moderation actions, undoing an action requires the same one.
+var modActionPermission = map[entity.ModAction]entity.Action{
+	entity.ModActionBlockPost:     entity.ActionBlockPost,
+	entity.ModActionUnblockPost:   entity.ActionBlockPost,
+	entity.ModActionBlockThread:   entity.ActionBlockThread,
+	entity.ModActionUnblockThread: entity.ActionBlockThread,
+	entity.ModActionLockThread:    entity.ActionLockThread,
+	entity.ModActionUnlockThread:  entity.ActionLockThread,
+	entity.ModActionPinThread:     entity.ActionPinThread,
+	entity.ModActionUnpinThread:   entity.ActionPinThread,
+	entity.ModActionEditTags:      entity.ActionEditTag,
+	entity.ModActionBanUser:       entity.ActionBanUser,
+	entity.ModActionUnbanUser:     entity.ActionBanUser,
+	entity.ModActionBanNetwork:    entity.ActionBanUser,
+	entity.ModActionUnbanNetwork:  entity.ActionBanUser,
+}
+
+func (s *Service) UnlockThread(ctx context.Context, threadID uid.UID, reason *string) (*entity.Thread, error) {
+	if err := MutCost(ctx, 1); err != nil {
 		return nil, err
 	}
-	return post, nil
+	return s.moderateThread(ctx, threadID, entity.ModActionUnlockThread, reason, func(thread *entity.Thread) error {
+		thread.Reopen()
+		return nil
+	})
 }
 
-func (s *Service) BlockPost(ctx context.Context, postID uid.UID) (*entity.Post, error) {
-	if err := Cost(ctx, 1); err != nil {
+// PinThread pins thread at the top of its main tag until the time, or forever if until is nil.
+func (s *Service) PinThread(
+	ctx context.Context, threadID uid.UID, until *time.Time, reason *string,
+) (*entity.Thread, error) {
+	if err := MutCost(ctx, 1); err != nil {
 		return nil, err
 	}
-	user := entity.GetCurrentUser(ctx)
-	if err := user.RequirePermission(entity.ActionBlockPost); err != nil {
+	return s.moderateThread(ctx, threadID, entity.ModActionPinThread, reason, func(thread *entity.Thread) error {
+		return thread.Pin(until)
+	})
+}
+
+func (s *Service) UnpinThread(ctx context.Context, threadID uid.UID, reason *string) (*entity.Thread, error) {
+	if err := MutCost(ctx, 1); err != nil {
 		return nil, err
 	}
-	post, err := s.Repo.Post.GetByID(ctx, postID)
-	if err != nil {
+	return s.moderateThread(ctx, threadID, entity.ModActionUnpinThread, reason, func(thread *entity.Thread) error {
+		thread.Unpin()
+		return nil
+	})
+}
+
+func (s *Service) BlockThread(ctx context.Context, threadID uid.UID, reason *string) (*entity.Thread, error) {
+	if err := MutCost(ctx, 1); err != nil {
 		return nil, err
 	}
-	post.Block()
-	return s.Repo.Post.Update(ctx, post)
+	return s.moderateThread(ctx, threadID, entity.ModActionBlockThread, reason, func(thread *entity.Thread) error {
+		thread.Block()
+		return nil
+	})
+}
+
+// UnblockThread restores the original title and content of thread.
+func (s *Service) UnblockThread(ctx context.Context, threadID uid.UID, reason *string) (*entity.Thread, error) {
+	if err := MutCost(ctx, 1); err != nil {
+		return nil, err
+	}
+	return s.moderateThread(ctx, threadID, entity.ModActionUnblockThread, reason, func(thread *entity.Thread) error {
+		thread.Unblock()
+		return nil
+	})
+}
+
+func (s *Service) EditTags(
+	ctx context.Context, threadID uid.UID, mainTag string, subTags []string, reason *string,
+) (*entity.Thread, error) {
+	if err := MutCost(ctx, 1); err != nil {
+		return nil, err
+	}
+	return s.moderateThread(ctx, threadID, entity.ModActionEditTags, reason, func(thread *entity.Thread) error {
+		// moving thread to another main tag requires permission in both of them.
+		user := entity.GetCurrentUser(ctx)
+		if err := user.RequireTagPermission(entity.ActionEditTag, mainTag); err != nil {
+			return err
+		}
+		return thread.EditTags(mainTag, subTags)
+	})
+}
+
+func (s *Service) SearchThreads(
+	ctx context.Context, tags []string, sort *entity.ThreadSort, query entity.SliceQuery,
+) (*entity.ThreadSlice, error) {
+	if err := Cost(ctx, query.Limit); err != nil {
+		return nil, err
+	}
+	search := &entity.ThreadsSearch{Tags: tags, Sort: entity.ThreadSortBump}
+	if sort != nil {
+		search.Sort = *sort
+	}
+	if user := entity.GetCurrentUser(ctx); user != nil {
+		search.ExcludedTags = user.ExcludedTags(tags)
+		search.HiddenBy = &user.ID
+	}
+	return s.Repo.Thread.FindSlice(ctx, search, query)
+}
+
+// GetTimeline returns threads in subscribed tags of current user, or in main tags if there is none,
+// blocked threads are left out.
+func (s *Service) GetTimeline(ctx context.Context, query entity.SliceQuery) (*entity.ThreadSlice, error) {
+	if err := Cost(ctx, query.Limit); err != nil {
+		return nil, err
+	}
+	user := entity.GetCurrentUser(ctx)
+	var tags []string
+	if user != nil {
+		tags = user.Tags
+	}
+	if len(tags) == 0 {
+		tags = config.GetMainTags()
+	}
+	search := &entity.ThreadsSearch{
+		Tags:        tags,
+		Sort:        entity.ThreadSortBump,
+		SkipPinned:  true,
+		SkipBlocked: true,
+	}
+	if user != nil {
+		// muted tags are not excluded if they are subscribed.
+		search.ExcludedTags = user.ExcludedTags(user.Tags)
+		search.HiddenBy = &user.ID
+	}
+	return s.Repo.Thread.FindSlice(ctx, search, query)
+}
+
+func (s *Service) GetThreadByID(ctx context.Context, id uid.UID) (*entity.Thread, error) {
+	if err := Cost(ctx, 1); err != nil {
+		return nil, err
+	}
+	return s.Repo.Thread.GetByID(ctx, id)
+}
+
+func (s *Service) GetThreadReplies(ctx context.Context, thread *entity.Thread, query entity.SliceQuery) (*entity.PostSlice, error) {
+	if err := Cost(ctx, query.Limit); err != nil {
+		return nil, err
+	}
+	postSlice, err := s.Repo.Thread.Replies(ctx, thread, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "Thread.Replies")
+	}
+	return postSlice, err
+}
+
+func (s *Service) GetThreadReplyCount(ctx context.Context, thread *entity.Thread) (int, error) {
+	if ls := getLoaders(ctx); ls != nil {
+		count, err := loadCount(ctx, &ls.replyCount, thread.ID)
+		return count, errors.Wrap(err, "Thread.ReplyCounts")
+	}
+	count, err := s.Repo.Thread.ReplyCount(ctx, thread)
+	return count, errors.Wrap(err, "Thread.ReplyCount")
+}
+
+// GetThreadWatched reports whether current user watches the thread, always false for guests.
+func (s *Service) GetThreadWatched(ctx context.Context, thread *entity.Thread) (bool, error) {
+	user := entity.GetCurrentUser(ctx)
+	if user.RequirePermission(entity.ActionWatchThread) != nil {
+		return false, nil
+	}
+	return s.Repo.Thread.IsWatched(ctx, thread.ID, user.ID)
+}
+
+func (s *Service) GetThreadCatalog(ctx context.Context, thread *entity.Thread) ([]*entity.ThreadCatalogItem, error) {
+	catalogs, err := s.Repo.Thread.Catalog(ctx, thread)
+	return catalogs, errors.Wrap(err, "Thread.Catalog")
+}
+
+// ---- Post Part ----
+
+func (s *Service) PubPost(ctx context.Context, input entity.PostInput) (*entity.Post, error) {
+	if err := MutCost(ctx, config.Get().RateLimit.Cost.PubPost); err != nil {
+		return nil, err
+	}
+	user := entity.GetCurrentUser(ctx)
+	if err := user.RequirePermission(entity.ActionPubPost); err != nil {
+		return nil, errors.Wrapf(err, "PubPost(input=%+v)", input)
+	}
+	if err := s.checkPubPost(ctx, user, input.Content); err != nil {
+		return nil, err
+	}
+	var post *entity.Post
+	var thread *entity.Thread
+	err := s.TxAdapter.WithTx(ctx, func() error {
+		var err error
+		thread, err = s.Repo.Thread.GetByID(ctx, input.ThreadID)
+		if err != nil {
+			return errors.Wrap(err, "find thread")
+		}
+		post, err = entity.NewPost(&input, user, thread)
+		if err != nil {
+			return errors.Wrap(err, "NewPost")
+		}
+		post.Author.IP = getClientIP(ctx)
+		post, err = s.Repo.Post.Insert(ctx, post)
+		if err != nil {
+			return errors.Wrapf(err, "PubPost(input=%+v)", input)
+		}
+		if user.AutoWatch && user.RequirePermission(entity.ActionWatchThread) == nil {
+			if err := s.Repo.Thread.Watch(ctx, thread.ID, user.ID); err != nil {
+				return err
+			}
+		}
+		quotedPost, err := s.Repo.Post.QuotedPosts(ctx, post)
+		if err != nil {
+			return err
+		}
+		return s.NewNotiOnNewPost(ctx, user, thread, post, quotedPost)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Repo.Post.PublishNewPost(ctx, post); err != nil {
+		log.Error(errors.Wrap(err, "PubPost"))
+	}
+	return post, nil
+}
+
+// checkPubPost runs the checks of publishing post, which edits of post pass as well.
+func (s *Service) checkPubPost(ctx context.Context, user *entity.User, content string) error {
+	if err := s.checkNetBan(ctx, user); err != nil {
+		return err
+	}
+	if err := s.Repo.Post.CheckIfDuplicated(ctx, user.ID, content); err != nil {
+		return errors.Wrap(err, "Post.CheckIfDuplicated")
+	}
+	return nil
+}
+
+// EditPost changes content of post by its author, the previous version is kept as revision.
+func (s *Service) EditPost(ctx context.Context, postID uid.UID, content string) (*entity.Post, error) {
+	if err := MutCost(ctx, config.Get().RateLimit.Cost.PubPost); err != nil {
+		return nil, err
+	}
+	user := entity.GetCurrentUser(ctx)
+	if err := user.RequirePermission(entity.ActionPubPost); err != nil {
+		return nil, err
+	}
+	var post *entity.Post
+	err := s.TxAdapter.WithTx(ctx, func() error {
+		var err error
+		post, err = s.Repo.Post.GetByID(ctx, postID)
+		if err != nil {
+			return err
+		}
+		thread, err := s.Repo.Thread.GetByID(ctx, post.ThreadID)
+		if err != nil {
+			return err
+		}
+		rev, err := post.Edit(user, thread, content)
+		if err != nil {
+			return err
+		}
+		if err := s.checkPubPost(ctx, user, content); err != nil {
+			return err
+		}
+		if err := s.Repo.Revision.Insert(ctx, rev); err != nil {
+			return err
+		}
+		post, err = s.Repo.Post.UpdateContent(ctx, post)
+		return err
+	})
+	return post, err
+}
+
+func (s *Service) BlockPost(ctx context.Context, postID uid.UID, reason *string) (*entity.Post, error) {
+	if err := MutCost(ctx, 1); err != nil {
+		return nil, err
+	}
+	return s.moderatePost(ctx, postID, entity.ModActionBlockPost, reason, func(post *entity.Post) {
+		post.Block()
+	})
+}
+
+// UnblockPost restores the original content of post.
+func (s *Service) UnblockPost(ctx context.Context, postID uid.UID, reason *string) (*entity.Post, error) {
+	if err := MutCost(ctx, 1); err != nil {
+		return nil, err
+	}
+	return s.moderatePost(ctx, postID, entity.ModActionUnblockPost, reason, func(post *entity.Post) {
+		post.Unblock()
+	})
+}
+
+// moderatePost applies the moderation action to post and records it in mod log in one transaction.
+// Roles granted in the main tag of thread are counted in permission check.
+func (s *Service) moderatePost(
+	ctx context.Context, postID uid.UID, action entity.ModAction, reason *string, fn func(post *entity.Post),
+) (*entity.Post, error) {
+	user := entity.GetCurrentUser(ctx)
+	var post *entity.Post
+	err := s.TxAdapter.WithTx(ctx, func() error {
+		var err error
+		post, err = s.Repo.Post.GetByID(ctx, postID)
+		if err != nil {
+			return err
+		}
+		thread, err := s.Repo.Thread.GetByID(ctx, post.ThreadID)
+		if err != nil {
+			return err
+		}
+		if err := user.RequireTagPermission(modActionPermission[action], thread.MainTag); err != nil {
+			return err
+		}
+		before := post.ModState()
+		fn(post)
+		post, err = s.Repo.Post.Update(ctx, post)
+		if err != nil {
+			return err
+		}
+		return s.modLog(ctx, user, action, post.ID, reason, before, post.ModState())
+	})
+	return post, err
 }
 
 func (s *Service) GetPostQuotedPosts(ctx context.Context, post *entity.Post) ([]*entity.Post, error) {
+	if ls := getLoaders(ctx); ls != nil {
+		quotes, err := ls.loadPosts(ctx, post.QuoteIDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "Post.GetByIDs")
+		}
+		return quotes, nil
+	}
 	quotes, err := s.Repo.Post.QuotedPosts(ctx, post)
 	if err != nil {
-		return nil, errors.Wrap(err, "Post.QuotedPosts")
+		return nil, errors.Wrap(err, "Post.QuotedPosts")
+	}
+	return quotes, nil
+}
+
+func (s *Service) GetPostQuotedCount(ctx context.Context, post *entity.Post) (int, error) {
+	if ls := getLoaders(ctx); ls != nil {
+		count, err := loadCount(ctx, &ls.quotedCount, post.ID)
+		if err != nil {
+			return 0, errors.Wrap(err, "Post.QuotedCounts")
+		}
+		return count, nil
+	}
+	count, err := s.Repo.Post.QuotedCount(ctx, post)
+	if err != nil {
+		return 0, errors.Wrap(err, "Post.QuotedCount")
+	}
+	return count, nil
+}
+
+// GetRevisions returns previous versions of thread or post, newest first. Only moderators can see them.
+func (s *Service) GetRevisions(ctx context.Context, targetID uid.UID) ([]*entity.Revision, error) {
+	user := entity.GetCurrentUser(ctx)
+	if err := user.RequirePermission(entity.ActionViewRevision); err != nil {
+		return nil, nil
+	}
+	return s.Repo.Revision.FindByTarget(ctx, targetID)
+}
+
+func (s *Service) GetPostByID(ctx context.Context, id uid.UID) (*entity.Post, error) {
+	if err := Cost(ctx, 1); err != nil {
+		return nil, err
+	}
+	return s.Repo.Post.GetByID(ctx, id)
+}
+
+// SubscribeThreadReplies pushes new posts of the thread until ctx is done.
+func (s *Service) SubscribeThreadReplies(ctx context.Context, threadID uid.UID) (<-chan *entity.Post, error) {
+	if err := Cost(ctx, 1); err != nil {
+		return nil, err
+	}
+	if _, err := s.Repo.Thread.GetByID(ctx, threadID); err != nil {
+		return nil, errors.Wrapf(err, "SubscribeThreadReplies(threadID=%v)", threadID)
+	}
+	ids, err := s.Repo.Post.SubscribeNewPosts(ctx, threadID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "SubscribeThreadReplies(threadID=%v)", threadID)
+	}
+	posts := make(chan *entity.Post)
+	go func() {
+		defer close(posts)
+		for id := range ids {
+			post, err := s.Repo.Post.GetByID(ctx, id)
+			if err != nil {
+				log.Error(errors.Wrapf(err, "SubscribeThreadReplies(threadID=%v)", threadID))
+				continue
+			}
+			select {
+			case posts <- post:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return posts, nil
+}
+
+// ---- Bookmark Part ----
+
+func (s *Service) Bookmark(ctx context.Context, threadID *uid.UID, postID *uid.UID) (bool, error) {
+	if err := MutCost(ctx, 1); err != nil {
+		return false, err
+	}
+	user := entity.GetCurrentUser(ctx)
+	if err := user.RequirePermission(entity.ActionBookmark); err != nil {
+		return false, err
+	}
+	var item entity.BookmarkItem
+	switch {
+	case threadID != nil:
+		thread, err := s.Repo.Thread.GetByID(ctx, *threadID)
+		if err != nil {
+			return false, err
+		}
+		item = thread
+	case postID != nil:
+		post, err := s.Repo.Post.GetByID(ctx, *postID)
+		if err != nil {
+			return false, err
+		}
+		item = post
+	default:
+		return false, errors.BadParams.New("must specify thread id or post id")
+	}
+	if err := s.Repo.Bookmark.Insert(ctx, user.ID, item); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *Service) Unbookmark(ctx context.Context, threadID *uid.UID, postID *uid.UID) (bool, error) {
+	if err := MutCost(ctx, 1); err != nil {
+		return false, err
+	}
+	user := entity.GetCurrentUser(ctx)
+	if err := user.RequirePermission(entity.ActionBookmark); err != nil {
+		return false, err
+	}
+	var targetID uid.UID
+	switch {
+	case threadID != nil:
+		targetID = *threadID
+	case postID != nil:
+		targetID = *postID
+	default:
+		return false, errors.BadParams.New("must specify thread id or post id")
+	}
+	if err := s.Repo.Bookmark.Delete(ctx, user.ID, targetID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *Service) GetUserBookmarks(
+	ctx context.Context, obj *entity.User, query entity.SliceQuery,
+) (*entity.BookmarkSlice, error) {
+	if err := Cost(ctx, query.Limit); err != nil {
+		return nil, err
+	}
+	user := entity.GetCurrentUser(ctx)
+	if err := user.RequirePermission(entity.ActionBookmark); err != nil {
+		return nil, err
+	}
+	if obj == nil || obj.ID != user.ID {
+		return nil, errors.Permission.New("permission denied")
+	}
+	return s.Repo.Bookmark.GetSlice(ctx, user.ID, query)
+}
+
+// GetBookmarked reports whether current user has bookmarked the thread or post.
+func (s *Service) GetBookmarked(ctx context.Context, targetID uid.UID) (bool, error) {
+	user := entity.GetCurrentUser(ctx)
+	if user.RequirePermission(entity.ActionBookmark) != nil {
+		return false, nil
+	}
+	return s.Repo.Bookmark.IsBookmarked(ctx, user.ID, targetID)
+}
+
+// ---- Report Part ----
+
+func (s *Service) ReportThread(
+	ctx context.Context, threadID uid.UID, reason entity.ReportReason, text *string,
+) (bool, error) {
+	if err := MutCost(ctx, 1); err != nil {
+		return false, err
+	}
+	user := entity.GetCurrentUser(ctx)
+	if err := user.RequirePermission(entity.ActionReport); err != nil {
+		return false, err
+	}
+	thread, err := s.Repo.Thread.GetByID(ctx, threadID)
+	if err != nil {
+		return false, err
+	}
+	report, err := entity.NewThreadReport(user, thread, reason, text)
+	if err != nil {
+		return false, err
+	}
+	if err := s.Repo.Report.Insert(ctx, report); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *Service) ReportPost(
+	ctx context.Context, postID uid.UID, reason entity.ReportReason, text *string,
+) (bool, error) {
+	if err := MutCost(ctx, 1); err != nil {
+		return false, err
+	}
+	user := entity.GetCurrentUser(ctx)
+	if err := user.RequirePermission(entity.ActionReport); err != nil {
+		return false, err
+	}
+	post, err := s.Repo.Post.GetByID(ctx, postID)
+	if err != nil {
+		return false, err
+	}
+	report, err := entity.NewPostReport(user, post, reason, text)
+	if err != nil {
+		return false, err
+	}
+	if err := s.Repo.Report.Insert(ctx, report); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *Service) GetReports(
+	ctx context.Context, status *entity.ReportStatus, query entity.SliceQuery,
+) (*entity.ReportSlice, error) {
+	if err := Cost(ctx, query.Limit); err != nil {
+		return nil, err
+	}
+	user := entity.GetCurrentUser(ctx)
+	if err := user.RequirePermission(entity.ActionHandleReport); err != nil {
+		return nil, err
+	}
+	st := entity.ReportStatusPending
+	if status != nil {
+		st = *status
+	}
+	return s.Repo.Report.GetSlice(ctx, st, query)
+}
+
+// ResolveReport closes the report and takes actions on the reported content in one transaction.
+func (s *Service) ResolveReport(
+	ctx context.Context, reportID uid.UID, action *entity.ReportAction, reason *string,
+) (*entity.Report, error) {
+	if err := MutCost(ctx, 1); err != nil {
+		return nil, err
+	}
+	user := entity.GetCurrentUser(ctx)
+	if err := user.RequirePermission(entity.ActionHandleReport); err != nil {
+		return nil, err
+	}
+	var report *entity.Report
+	err := s.TxAdapter.WithTx(ctx, func() error {
+		var err error
+		report, err = s.Repo.Report.GetByID(ctx, reportID)
+		if err != nil {
+			return err
+		}
+		if err := report.Handle(user, entity.ReportStatusResolved); err != nil {
+			return err
+		}
+		if action != nil {
+			if err := s.takeReportAction(ctx, report, action, reason); err != nil {
+				return err
+			}
+		}
+		report, err = s.Repo.Report.Update(ctx, report)
+		return err
+	})
+	return report, err
+}
+
+func (s *Service) takeReportAction(
+	ctx context.Context, report *entity.Report, action *entity.ReportAction, reason *string,
+) error {
+	var author *entity.Author
+	if report.PostID != nil {
+		post, err := s.Repo.Post.GetByID(ctx, *report.PostID)
+		if err != nil {
+			return err
+		}
+		author = post.Author
+		if algo.NullToBool(action.Block) {
+			if _, err := s.moderatePost(ctx, post.ID, entity.ModActionBlockPost, reason, func(post *entity.Post) {
+				post.Block()
+			}); err != nil {
+				return err
+			}
+		}
+	} else {
+		thread, err := s.Repo.Thread.GetByID(ctx, report.ThreadID)
+		if err != nil {
+			return err
+		}
+		author = thread.Author
+		if algo.NullToBool(action.Block) {
+			if _, err := s.moderateThread(ctx, thread.ID, entity.ModActionBlockThread, reason, func(thread *entity.Thread) error {
+				thread.Block()
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	if algo.NullToBool(action.Lock) {
+		if _, err := s.moderateThread(ctx, report.ThreadID, entity.ModActionLockThread, reason, func(thread *entity.Thread) error {
+			thread.Lock()
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	if algo.NullToBool(action.BanUser) {
+		if _, err := s.banAuthor(ctx, author, reason, action.BanDuration); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) GetReportThread(ctx context.Context, report *entity.Report) (*entity.Thread, error) {
+	return s.Repo.Thread.GetByID(ctx, report.ThreadID)
+}
+
+func (s *Service) GetReportPost(ctx context.Context, report *entity.Report) (*entity.Post, error) {
+	if report.PostID == nil {
+		return nil, nil
+	}
+	return s.Repo.Post.GetByID(ctx, *report.PostID)
+}
+
+func (s *Service) DismissReport(ctx context.Context, reportID uid.UID) (*entity.Report, error) {
+	if err := MutCost(ctx, 1); err != nil {
+		return nil, err
+	}
+	user := entity.GetCurrentUser(ctx)
+	if err := user.RequirePermission(entity.ActionHandleReport); err != nil {
+		return nil, err
+	}
+	report, err := s.Repo.Report.GetByID(ctx, reportID)
+	if err != nil {
+		return nil, err
 	}
-	return quotes, nil
+	if err := report.Handle(user, entity.ReportStatusDismissed); err != nil {
+		return nil, err
+	}
+	return s.Repo.Report.Update(ctx, report)
 }
 
-func (s *Service) GetPostQuotedCount(ctx context.Context, post *entity.Post) (int, error) {
-	count, err := s.Repo.Post.QuotedCount(ctx, post)
+// ---- Mod Log Part ----
+
+func (s *Service) GetModLogs(ctx context.Context, query entity.SliceQuery) (*entity.ModLogSlice, error) {
+	if err := Cost(ctx, query.Limit); err != nil {
+		return nil, err
+	}
+	user := entity.GetCurrentUser(ctx)
+	if err := user.RequirePermission(entity.ActionViewModLog); err != nil {
+		return nil, err
+	}
+	return s.Repo.ModLog.GetSlice(ctx, query)
+}
+
+// GetModLogActor returns nil if the actor is not found.
+func (s *Service) GetModLogActor(ctx context.Context, l *entity.ModLog) (*entity.User, error) {
+	actor, err := s.Repo.User.GetByID(ctx, l.ActorID)
+	if errors.Is(err, errors.NotFound) {
+		return nil, nil
+	}
+	return actor, err
+}
+
+// ---- Network Ban Part ----
+
+// AttachClientIP attaches ip of client to context, which is recorded with published content for network bans.
+func (s *Service) AttachClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey, ip)
+}
+
+func getClientIP(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPKey).(string)
+	return ip
+}
+
+// CheckNetBan returns a Permission error if the ip is in a banned network.
+func (s *Service) CheckNetBan(ctx context.Context, ip string) error {
+	if net.ParseIP(ip) == nil {
+		return nil
+	}
+	ban, err := s.Repo.NetBan.Match(ctx, ip)
 	if err != nil {
-		return 0, errors.Wrap(err, "Post.QuotedCount")
+		return err
 	}
-	return count, nil
+	if ban != nil {
+		return ban.PermissionError()
+	}
+	return nil
 }
 
-func (s *Service) GetPostByID(ctx context.Context, id uid.UID) (*entity.Post, error) {
-	if err := Cost(ctx, 1); err != nil {
+// checkNetBan checks the client of context, moderators are not affected by network bans.
+func (s *Service) checkNetBan(ctx context.Context, user *entity.User) error {
+	if user.RequirePermission(entity.ActionBanUser) == nil {
+		return nil
+	}
+	return s.CheckNetBan(ctx, getClientIP(ctx))
+}
+
+// BanNetwork bans the network which the post or thread is published from, for duration hours or forever if it's nil.
+func (s *Service) BanNetwork(
+	ctx context.Context, postID *uid.UID, threadID *uid.UID, prefix *int, reason *string, duration *int,
+) (*entity.NetBan, error) {
+	if err := MutCost(ctx, 1); err != nil {
 		return nil, err
 	}
-	return s.Repo.Post.GetByID(ctx, id)
+	user := entity.GetCurrentUser(ctx)
+	if err := user.RequirePermission(modActionPermission[entity.ModActionBanNetwork]); err != nil {
+		return nil, err
+	}
+	expiresAt, err := banExpiry(duration)
+	if err != nil {
+		return nil, err
+	}
+	author, err := s.findAuthor(ctx, postID, threadID)
+	if err != nil {
+		return nil, err
+	}
+	var targetID uid.UID
+	if postID != nil {
+		targetID = *postID
+	} else {
+		targetID = *threadID
+	}
+	ban, err := entity.NewNetBan(user, author, targetID, prefix, reason, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	err = s.TxAdapter.WithTx(ctx, func() error {
+		if err := s.Repo.NetBan.Insert(ctx, ban); err != nil {
+			return err
+		}
+		return s.modLog(ctx, user, entity.ModActionBanNetwork, ban.ID, reason, nil, ban.ModState())
+	})
+	return ban, err
+}
+
+func (s *Service) UnbanNetwork(ctx context.Context, id uid.UID, reason *string) (bool, error) {
+	if err := MutCost(ctx, 1); err != nil {
+		return false, err
+	}
+	user := entity.GetCurrentUser(ctx)
+	if err := user.RequirePermission(modActionPermission[entity.ModActionUnbanNetwork]); err != nil {
+		return false, err
+	}
+	err := s.TxAdapter.WithTx(ctx, func() error {
+		ban, err := s.Repo.NetBan.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := s.Repo.NetBan.Delete(ctx, id); err != nil {
+			return err
+		}
+		return s.modLog(ctx, user, entity.ModActionUnbanNetwork, id, reason, ban.ModState(), nil)
+	})
+	return err == nil, err
+}
+
+func (s *Service) GetNetBans(ctx context.Context, query entity.SliceQuery) (*entity.NetBanSlice, error) {
+	if err := Cost(ctx, query.Limit); err != nil {
+		return nil, err
+	}
+	user := entity.GetCurrentUser(ctx)
+	if err := user.RequirePermission(entity.ActionBanUser); err != nil {
+		return nil, err
+	}
+	return s.Repo.NetBan.GetSlice(ctx, query)
+}
+
+// ---- Search Part ----
+
+func (s *Service) Search(
+	ctx context.Context, text string, tags []string, query entity.SliceQuery,
+) (*entity.SearchSlice, error) {
+	if err := Cost(ctx, query.Limit); err != nil {
+		return nil, err
+	}
+	search, err := entity.NewContentSearch(text, tags)
+	if err != nil {
+		return nil, err
+	}
+	if user := entity.GetCurrentUser(ctx); user != nil {
+		search.ExcludedTags = user.ExcludedTags(tags)
+		search.HiddenBy = &user.ID
+	}
+	slice, err := s.Repo.Search.Search(ctx, search, query)
+	return slice, errors.Wrapf(err, "Search(text=%s, tags=%v, query=%+v)", text, tags, query)
+}
+
+// CheckSearch checks that the database supports search of CJK text.
+func (s *Service) CheckSearch(ctx context.Context) error {
+	return s.Repo.Search.CheckCJK(s.TxAdapter.AttachDB(ctx))
 }
 
 // ---- Tag Part ----
@@ -422,12 +1430,270 @@ func (s *Service) SetMainTags(ctx context.Context, tags []string) error {
 	return nil
 }
 
+// editTags runs fn to change main tags or aliases, then reloads them in all instances.
+func (s *Service) editTags(ctx context.Context, fn func() error) error {
+	user := entity.GetCurrentUser(ctx)
+	if err := user.RequirePermission(entity.ActionEditSetting); err != nil {
+		return err
+	}
+	if err := MutCost(ctx, 1); err != nil {
+		return err
+	}
+	if err := s.TxAdapter.WithTx(ctx, func() error {
+		if err := fn(); err != nil {
+			return err
+		}
+		s.Repo.Tag.PublishTagsChanged(ctx)
+		return nil
+	}); err != nil {
+		return err
+	}
+	return loadTags(s)
+}
+
+func (s *Service) editMainTags(ctx context.Context, fn func() error) ([]string, error) {
+	if err := s.editTags(ctx, fn); err != nil {
+		return nil, err
+	}
+	return config.GetMainTags(), nil
+}
+
+func (s *Service) AddMainTag(ctx context.Context, tag string) ([]string, error) {
+	if err := entity.ValidateMainTag(tag); err != nil {
+		return nil, err
+	}
+	return s.editMainTags(ctx, func() error {
+		return s.Repo.Tag.AddMainTag(ctx, tag)
+	})
+}
+
+// RenameMainTag renames a main tag, threads and users with it are updated as well.
+func (s *Service) RenameMainTag(ctx context.Context, tag, newName string) ([]string, error) {
+	if err := entity.ValidateMainTag(newName); err != nil {
+		return nil, err
+	}
+	return s.editMainTags(ctx, func() error {
+		return s.Repo.Tag.RenameMainTag(ctx, tag, newName)
+	})
+}
+
+// RetireMainTag stops a main tag from being used by new threads, existing threads keep it.
+func (s *Service) RetireMainTag(ctx context.Context, tag string) ([]string, error) {
+	return s.editMainTags(ctx, func() error {
+		if mainTags := config.GetMainTags(); len(mainTags) == 1 && mainTags[0] == tag {
+			return errors.BadParams.New("can't retire the last main tag")
+		}
+		return s.Repo.Tag.RetireMainTag(ctx, tag)
+	})
+}
+
+// ReorderMainTags sorts main tags, tags must contain all current main tags.
+func (s *Service) ReorderMainTags(ctx context.Context, tags []string) ([]string, error) {
+	return s.editMainTags(ctx, func() error {
+		mainTags, err := s.Repo.Tag.GetMainTags(ctx)
+		if err != nil {
+			return err
+		}
+		if len(tags) != len(mainTags) {
+			return errors.BadParams.New("tags must contain all main tags once")
+		}
+		set := map[string]bool{}
+		for _, t := range mainTags {
+			set[t] = true
+		}
+		for _, t := range tags {
+			if !set[t] {
+				return errors.BadParams.New("tags must contain all main tags once")
+			}
+			delete(set, t)
+		}
+		return s.Repo.Tag.ReorderMainTags(ctx, tags)
+	})
+}
+
+// MergeTags merges sub tag from into another sub tag, from becomes an alias and new content uses into instead.
+func (s *Service) MergeTags(ctx context.Context, from, into string) (bool, error) {
+	into = config.CanonicalTag(into)
+	if from == into {
+		return false, errors.BadParams.New("can't merge a tag into itself")
+	}
+	if strings.TrimSpace(into) == "" {
+		return false, errors.BadParams.New("tag must not be empty")
+	}
+	if config.IsMainTag(from) || config.IsMainTag(into) {
+		return false, errors.BadParams.New("only sub tags can be merged")
+	}
+	if err := s.editTags(ctx, func() error {
+		return s.Repo.Tag.MergeTags(ctx, from, into)
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// WatchTags reloads main tags and aliases when they are changed by other instances, until ctx is done.
+func (s *Service) WatchTags(ctx context.Context) error {
+	changes, err := s.Repo.Tag.SubscribeTagsChanged(ctx)
+	if err != nil {
+		return err
+	}
+	go func() {
+		for range changes {
+			if err := loadTags(s); err != nil {
+				log.Error(errors.Wrap(err, "reload tags"))
+			}
+		}
+	}()
+	return nil
+}
+
 func (s *Service) GetMainTags(ctx context.Context) []string {
 	return config.GetMainTags()
 }
 
-func (s *Service) GetRecommendedTags(ctx context.Context) []string {
-	return config.GetMainTags()
+// cooccurrenceWeight is the weight of co-occurrence with subscribed tags in recommending,
+// comparing with the activity of tag itself.
+const cooccurrenceWeight = 2
+
+// GetRecommendedTags returns trending sub tags of last week which are not subscribed,
+// the ones occurring with subscribed tags of current user are preferred.
+func (s *Service) GetRecommendedTags(ctx context.Context) ([]string, error) {
+	window := entity.TrendingWindowWeek
+	trending, err := s.Repo.Tag.GetTrending(ctx, window, entity.TrendingTagsLimit)
+	if err != nil {
+		return nil, err
+	}
+	var subscribed []string
+	if user := entity.GetCurrentUser(ctx); user != nil {
+		subscribed = user.Tags
+	}
+	skip := map[string]bool{}
+	for _, t := range subscribed {
+		skip[t] = true
+	}
+	var candidates []entity.TagScore
+	var names []string
+	for _, t := range trending {
+		if !skip[t.Name] && !config.IsMainTag(t.Name) {
+			candidates = append(candidates, t)
+			names = append(names, t.Name)
+		}
+	}
+	cooccurrence, err := s.Repo.Tag.GetCooccurrence(ctx, window, subscribed, names)
+	if err != nil {
+		return nil, err
+	}
+	for i := range candidates {
+		candidates[i].Score += cooccurrenceWeight * cooccurrence[candidates[i].Name]
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+	tags := []string{}
+	for i := 0; i < len(candidates) && i < entity.RecommendedTagsCount; i++ {
+		tags = append(tags, candidates[i].Name)
+	}
+	return tags, nil
+}
+
+func (s *Service) GetTrendingTags(ctx context.Context, window entity.TrendingWindow, limit *int) ([]*entity.Tag, error) {
+	l := algo.NullToIntDefault(limit, 10)
+	if l <= 0 || l > entity.TrendingTagsLimit {
+		return nil, errors.BadParams.Errorf("limit must be in 1 to %v", entity.TrendingTagsLimit)
+	}
+	if err := Cost(ctx, l); err != nil {
+		return nil, err
+	}
+	scores, err := s.Repo.Tag.GetTrending(ctx, window, l)
+	if err != nil {
+		return nil, err
+	}
+	tags := []*entity.Tag{}
+	for _, t := range scores {
+		tags = append(tags, &entity.Tag{Name: t.Name, IsMain: config.IsMainTag(t.Name)})
+	}
+	return tags, nil
+}
+
+// RefreshTrendingTags computes trending tags of all windows.
+func (s *Service) RefreshTrendingTags(ctx context.Context) error {
+	for _, w := range entity.AllTrendingWindow {
+		if err := s.Repo.Tag.RefreshTrending(ctx, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunTrendingRefresher refreshes trending tags every config.TrendingRefresh seconds until ctx is done,
+// only one of running instances refreshes in an interval.
+func (s *Service) RunTrendingRefresher(ctx context.Context) {
+	interval := time.Duration(config.Get().TrendingRefresh) * time.Second
+	if interval <= 0 {
+		return
+	}
+	refresh := func() {
+		ctx := s.TxAdapter.AttachDB(ctx)
+		if ok, err := s.Repo.Tag.LockTrendingRefresh(ctx, interval); err != nil || !ok {
+			if err != nil {
+				log.Error(err)
+			}
+			return
+		}
+		if err := s.RefreshTrendingTags(ctx); err != nil {
+			log.Error(errors.Wrap(err, "refresh trending tags"))
+		}
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		refresh()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				refresh()
+			}
+		}
+	}()
+}
+
+// RunTagPinyinIndexer fills pinyin of tags not indexed yet in background, such as the ones existing
+// before pinyin search. Tags added later are indexed on adding.
+func (s *Service) RunTagPinyinIndexer(ctx context.Context) {
+	go func() {
+		count, err := s.Repo.Tag.IndexPinyin(s.TxAdapter.AttachDB(ctx))
+		if err != nil {
+			log.Error(errors.Wrap(err, "index tag pinyin"))
+			return
+		}
+		if count > 0 {
+			log.Infof("indexed pinyin of %v tags", count)
+		}
+	}()
+}
+
+func (s *Service) GetTag(ctx context.Context, name string) (*entity.Tag, error) {
+	return s.Repo.Tag.GetByName(ctx, name)
+}
+
+// EditTag sets description and posting rules of main tag.
+func (s *Service) EditTag(ctx context.Context, name string, description, rules *string) (*entity.Tag, error) {
+	user := entity.GetCurrentUser(ctx)
+	if err := user.RequirePermission(entity.ActionEditSetting); err != nil {
+		return nil, err
+	}
+	if err := MutCost(ctx, 1); err != nil {
+		return nil, err
+	}
+	if err := entity.ValidateTagInfo(description, rules); err != nil {
+		return nil, err
+	}
+	if err := s.Repo.Tag.UpdateInfo(ctx, name, description, rules); err != nil {
+		return nil, err
+	}
+	return s.Repo.Tag.GetByName(ctx, name)
 }
 
 func (s *Service) SearchTags(ctx context.Context, query *string, limit *int) ([]*entity.Tag, error) {
@@ -473,6 +1739,53 @@ func (s *Service) GetNotifications(ctx context.Context, query entity.SliceQuery)
 	return slice, nil
 }
 
+// SubscribeUnreadNoti pushes the changed notification and unread count of current user until ctx is done.
+func (s *Service) SubscribeUnreadNoti(ctx context.Context) (<-chan *entity.UnreadNotiEvent, error) {
+	if err := Cost(ctx, 1); err != nil {
+		return nil, err
+	}
+	user := entity.GetCurrentUser(ctx)
+	if err := user.RequirePermission(entity.ActionProfile); err != nil {
+		return nil, err
+	}
+	keys, err := s.Repo.Noti.Subscribe(ctx, user)
+	if err != nil {
+		return nil, errors.Wrapf(err, "SubscribeUnreadNoti(user=%+v)", user)
+	}
+	events := make(chan *entity.UnreadNotiEvent)
+	go func() {
+		defer close(events)
+		for key := range keys {
+			event, err := s.unreadNotiEvent(ctx, user, key)
+			if err != nil {
+				log.Error(errors.Wrapf(err, "SubscribeUnreadNoti(user=%+v)", user))
+				continue
+			}
+			if event == nil {
+				continue
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
+
+func (s *Service) unreadNotiEvent(ctx context.Context, user *entity.User, key string) (*entity.UnreadNotiEvent, error) {
+	noti, err := s.Repo.Noti.GetByKey(ctx, user.ID, key)
+	if err != nil || noti == nil {
+		return nil, err
+	}
+	count, err := s.Repo.Noti.GetUnreadCount(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	return &entity.UnreadNotiEvent{UnreadCount: count, Notification: noti}, nil
+}
+
 func (s *Service) NewNotiOnNewUser(ctx context.Context, user *entity.User) error {
 	noti, err := entity.NewWelcomeNoti(user)
 	if err != nil {
@@ -481,39 +1794,48 @@ func (s *Service) NewNotiOnNewUser(ctx context.Context, user *entity.User) error
 	return s.Repo.Noti.Insert(ctx, noti)
 }
 
-func (s *Service) NewNotiOnNewPost(ctx context.Context, user *entity.User, thread *entity.Thread, post *entity.Post, quotedPosts []*entity.Post) {
-	if user.ID != thread.Author.UserID {
-		if err := s.newRepliedNoti(ctx, user, thread, post); err != nil {
-			log.Error(err)
+// NewNotiOnNewPost notifies watchers of the thread and authors of quoted posts. It must run in the
+// transaction publishing the post, a failed statement aborts the transaction, so errors are returned.
+func (s *Service) NewNotiOnNewPost(
+	ctx context.Context, user *entity.User, thread *entity.Thread, post *entity.Post, quotedPosts []*entity.Post,
+) error {
+	watchers, err := s.Repo.Thread.Watchers(ctx, thread.ID)
+	if err != nil {
+		return err
+	}
+	for _, watcher := range watchers {
+		if user.ID != watcher {
+			if err := s.newRepliedNoti(ctx, watcher, thread, post); err != nil {
+				return err
+			}
 		}
 	}
 	for _, qp := range quotedPosts {
 		if user.ID != qp.Author.UserID {
 			if err := s.newQuotedNoti(ctx, thread, post, qp); err != nil {
-				log.Error(err)
+				return err
 			}
 		}
 	}
+	return nil
 }
 
-func (s *Service) newRepliedNoti(ctx context.Context, user *entity.User, thread *entity.Thread, reply *entity.Post) error {
-	key := entity.RepliedNotiKey(thread)
-	prev, err := s.Repo.Noti.GetByKey(ctx, thread.Author.UserID, key)
+// newRepliedNoti inserts the replied notification, or merges the reply into the existing one.
+// Concurrent replies of the thread are serialized by the lock of the notification row.
+func (s *Service) newRepliedNoti(ctx context.Context, receiver uid.UID, thread *entity.Thread, reply *entity.Post) error {
+	inserted, err := s.Repo.Noti.InsertIfAbsent(ctx, entity.NewRepliedNoti(receiver, thread, reply))
+	if err != nil || inserted {
+		return err
+	}
+	key := entity.RepliedNotiKey(thread, receiver)
+	prev, err := s.Repo.Noti.LockByKey(ctx, receiver, key)
 	if err != nil {
-		if errors.Is(err, errors.NotFound) {
-			prev = nil
-		} else {
-			return errors.Wrap(err, "find upgrade replied noti")
-		}
+		return errors.Wrap(err, "find upgrade replied noti")
 	}
 	if prev == nil {
-		noti := entity.NewRepliedNoti(user, thread, reply)
-		if noti == nil {
-			return nil
-		}
-		return s.Repo.Noti.Insert(ctx, noti)
+		return errors.Internal.Errorf("replied noti %s not found after conflict", key)
 	}
-	prev.AddReply(user, thread, reply)
+	prev.AddReply(thread, reply)
 	return s.Repo.Noti.UpdateContent(ctx, prev)
 }
 
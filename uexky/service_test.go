@@ -2,13 +2,16 @@ package uexky
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"gitlab.com/abyss.club/uexky/lib/algo"
+	"gitlab.com/abyss.club/uexky/lib/config"
 	"gitlab.com/abyss.club/uexky/lib/errors"
 	"gitlab.com/abyss.club/uexky/lib/uid"
 	"gitlab.com/abyss.club/uexky/uexky/entity"
@@ -472,7 +475,7 @@ func TestService_BanUser(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := service.BanUser(tt.args.ctx, tt.args.postID, tt.args.threadID)
+			_, err := service.BanUser(tt.args.ctx, tt.args.postID, tt.args.threadID, nil, nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Service.BanUser() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -488,6 +491,250 @@ func TestService_BanUser(t *testing.T) {
 	}
 }
 
+func TestService_TemporaryBan(t *testing.T) {
+	service, ctx := initEnv(t, "MainA", "MainB", "MainC")
+
+	thread, _ := pubThread(t, service, testUser{email: "t@example.com"})
+	mod, _ := loginUser(t, service, testUser{email: "mod@example.com"})
+	mod.Role = entity.RoleMod
+	if _, err := service.Repo.User.Update(ctx, mod); err != nil {
+		t.Fatal(err)
+	}
+	_, modCtx := loginUser(t, service, testUser{email: "mod@example.com"})
+
+	t.Run("invalid duration", func(t *testing.T) {
+		_, err := service.BanUser(modCtx, nil, &thread.ID, nil, algo.NullInt(0))
+		if !errors.Is(err, errors.BadParams) {
+			t.Errorf("BanUser() error = %v, want BadParams", err)
+		}
+	})
+	t.Run("banned with reason and expiry", func(t *testing.T) {
+		if _, err := service.BanUser(modCtx, nil, &thread.ID, algo.NullString("spam"), algo.NullInt(24)); err != nil {
+			t.Fatal(err)
+		}
+		user, userCtx := loginUser(t, service, testUser{email: "t@example.com"})
+		if user.Role != entity.RoleBanned || user.BanExpiresAt == nil {
+			t.Fatalf("user = %+v, want banned with expiry", user)
+		}
+		if d := time.Until(*user.BanExpiresAt); d < 23*time.Hour || d > 24*time.Hour {
+			t.Errorf("ban expires in %v, want 24h", d)
+		}
+		err := user.RequirePermission(entity.ActionPubPost)
+		var uerr *errors.Error
+		if !errors.As(err, &uerr) || !errors.Is(err, errors.Permission) {
+			t.Fatalf("RequirePermission() error = %v, want Permission", err)
+		}
+		if reason := uerr.Extensions()["banReason"]; !cmp.Equal(reason, algo.NullString("spam")) {
+			t.Errorf("banReason extension = %v, want spam", reason)
+		}
+		notis, err := service.GetNotifications(userCtx, entity.SliceQuery{After: algo.NullString(""), Limit: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(notis.Notifications) == 0 {
+			t.Fatal("no notification received")
+		}
+		content, ok := notis.Notifications[0].Content.(entity.SystemNoti)
+		if !ok || content.Title != entity.BanTitle || !strings.Contains(content.Content, "spam") {
+			t.Errorf("latest notification = %+v, want ban notification", notis.Notifications[0])
+		}
+	})
+	t.Run("lifted after expiry", func(t *testing.T) {
+		user, _ := loginUser(t, service, testUser{email: "t@example.com"})
+		past := time.Now().Add(-time.Minute)
+		user.BanExpiresAt = &past
+		if _, err := service.Repo.User.Update(ctx, user); err != nil {
+			t.Fatal(err)
+		}
+		user, _ = loginUser(t, service, testUser{email: "t@example.com"})
+		if user.Role != entity.RoleNormal || user.BanReason != nil || user.BanExpiresAt != nil {
+			t.Errorf("user = %+v, want ban lifted", user)
+		}
+	})
+}
+
+func TestService_BanNetwork(t *testing.T) {
+	service, ctx := initEnv(t, "MainA", "MainB", "MainC")
+
+	thread, _ := pubThread(t, service, testUser{email: "t@example.com"})
+	publish := func(ip string) error {
+		_, guestCtx := loginUser(t, service, testUser{})
+		input := entity.PostInput{ThreadID: thread.ID, Anonymous: true, Content: uid.RandomBase64Str(50)}
+		_, err := service.PubPost(service.AttachClientIP(guestCtx, ip), input)
+		return err
+	}
+	_, guestCtx := loginUser(t, service, testUser{})
+	post, err := service.PubPost(service.AttachClientIP(guestCtx, "10.1.2.3"), entity.PostInput{
+		ThreadID: thread.ID, Anonymous: true, Content: uid.RandomBase64Str(50),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mod, _ := loginUser(t, service, testUser{email: "mod@example.com"})
+	mod.Role = entity.RoleMod
+	if _, err := service.Repo.User.Update(ctx, mod); err != nil {
+		t.Fatal(err)
+	}
+	_, modCtx := loginUser(t, service, testUser{email: "mod@example.com"})
+
+	t.Run("no ip recorded", func(t *testing.T) {
+		_, err := service.BanNetwork(modCtx, nil, &thread.ID, nil, nil, nil)
+		if !errors.Is(err, errors.BadParams) {
+			t.Errorf("BanNetwork() error = %v, want BadParams", err)
+		}
+	})
+	t.Run("no permission", func(t *testing.T) {
+		_, err := service.BanNetwork(guestCtx, &post.ID, nil, nil, nil, nil)
+		if !errors.Is(err, errors.Permission) {
+			t.Errorf("BanNetwork() error = %v, want Permission", err)
+		}
+	})
+	var ban *entity.NetBan
+	t.Run("ban network", func(t *testing.T) {
+		var err error
+		ban, err = service.BanNetwork(modCtx, &post.ID, nil, algo.NullInt(24), algo.NullString("spam"), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ban.Network != "10.1.2.0/24" || ban.TargetID != post.ID {
+			t.Errorf("BanNetwork() = %+v, want network 10.1.2.0/24 from the post", ban)
+		}
+		if err := publish("10.1.2.200"); !errors.Is(err, errors.Permission) {
+			t.Errorf("PubPost() in banned network error = %v, want Permission", err)
+		}
+		if err := publish("10.1.3.1"); err != nil {
+			t.Errorf("PubPost() out of banned network error = %v", err)
+		}
+		if err := service.CheckNetBan(ctx, "10.1.2.4"); !errors.Is(err, errors.Permission) {
+			t.Errorf("CheckNetBan() error = %v, want Permission", err)
+		}
+	})
+	t.Run("unban network", func(t *testing.T) {
+		if _, err := service.UnbanNetwork(modCtx, ban.ID, nil); err != nil {
+			t.Fatal(err)
+		}
+		if err := publish("10.1.2.200"); err != nil {
+			t.Errorf("PubPost() after unbanned error = %v", err)
+		}
+	})
+}
+
+func TestService_UnbanUser(t *testing.T) {
+	service, ctx := initEnv(t, "MainA", "MainB", "MainC")
+
+	thread, _ := pubThread(t, service, testUser{email: "t@example.com"})
+	post, _ := pubPost(t, service, testUser{email: "p@example.com"}, thread.ID)
+	author, _ := loginUser(t, service, testUser{email: "p@example.com"})
+	author.Role = entity.RoleMod
+	if _, err := service.Repo.User.Update(ctx, author); err != nil {
+		t.Fatal(err)
+	}
+	admin, _ := loginUser(t, service, testUser{email: "admin@example.com"})
+	admin.Role = entity.RoleAdmin
+	if _, err := service.Repo.User.Update(ctx, admin); err != nil {
+		t.Fatal(err)
+	}
+	_, adminCtx := loginUser(t, service, testUser{email: "admin@example.com"})
+	_, userCtx := loginUser(t, service, testUser{email: "u@example.com"})
+
+	t.Run("not banned", func(t *testing.T) {
+		if _, err := service.UnbanUser(adminCtx, &post.ID, nil, nil); !errors.Is(err, errors.BadParams) {
+			t.Errorf("UnbanUser() error = %v, want BadParams", err)
+		}
+	})
+	if _, err := service.BanUser(adminCtx, &post.ID, nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	t.Run("no permission", func(t *testing.T) {
+		if _, err := service.UnbanUser(userCtx, &post.ID, nil, nil); !errors.Is(err, errors.Permission) {
+			t.Errorf("UnbanUser() error = %v, want Permission", err)
+		}
+	})
+	t.Run("restore previous role", func(t *testing.T) {
+		unbanned, err := service.UnbanUser(adminCtx, &post.ID, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !unbanned {
+			t.Errorf("UnbanUser() = false, want true")
+		}
+		user, _ := loginUser(t, service, testUser{email: "p@example.com"})
+		if user.Role != entity.RoleMod || user.PrevRole != nil {
+			t.Errorf("user role = %v, prev role = %v, want mod and nil", user.Role, user.PrevRole)
+		}
+	})
+}
+
+func TestService_SetUserRole(t *testing.T) {
+	service, ctx := initEnv(t, "MainA", "MainB", "MainC")
+
+	admin, _ := loginUser(t, service, testUser{email: "admin@example.com"})
+	admin.Role = entity.RoleAdmin
+	if _, err := service.Repo.User.Update(ctx, admin); err != nil {
+		t.Fatal(err)
+	}
+	_, adminCtx := loginUser(t, service, testUser{email: "admin@example.com"})
+	target, _ := loginUser(t, service, testUser{email: "u@example.com"})
+	_, guestCtx := loginUser(t, service, testUser{})
+
+	t.Run("no permission", func(t *testing.T) {
+		_, err := service.SetUserRole(guestCtx, target.Email, nil, entity.RoleMod, nil, nil)
+		if !errors.Is(err, errors.Permission) {
+			t.Errorf("SetUserRole() error = %v, want Permission", err)
+		}
+	})
+	t.Run("promote", func(t *testing.T) {
+		user, err := service.SetUserRole(adminCtx, nil, &target.ID, entity.RoleMod, nil, algo.NullString("new mod"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if user.Role != entity.RoleMod {
+			t.Errorf("SetUserRole() role = %v, want mod", user.Role)
+		}
+	})
+	t.Run("grant in main tag", func(t *testing.T) {
+		if _, err := service.SetUserRole(adminCtx, target.Email, nil, entity.RoleMod, algo.NullString("SubA"), nil); !errors.Is(err, errors.BadParams) {
+			t.Errorf("SetUserRole() in sub tag error = %v, want BadParams", err)
+		}
+		user, err := service.SetUserRole(adminCtx, target.Email, nil, entity.RoleMod, algo.NullString("MainA"), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(user.TagRoles, []entity.TagRole{{Tag: "MainA", Role: entity.RoleMod}}); diff != "" {
+			t.Errorf("SetUserRole() tag roles diff: %s", diff)
+		}
+		user, err = service.SetUserRole(adminCtx, target.Email, nil, entity.RoleNormal, algo.NullString("MainA"), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(user.TagRoles) != 0 {
+			t.Errorf("SetUserRole() tag roles = %v, want revoked", user.TagRoles)
+		}
+	})
+	t.Run("demote self", func(t *testing.T) {
+		_, err := service.SetUserRole(adminCtx, admin.Email, nil, entity.RoleNormal, nil, nil)
+		if !errors.Is(err, errors.BadParams) {
+			t.Errorf("SetUserRole() error = %v, want BadParams", err)
+		}
+	})
+	t.Run("staff and audit", func(t *testing.T) {
+		staff, err := service.Repo.User.GetStaff(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(staff) != 2 {
+			t.Errorf("GetStaff() = %v, want admin and mod", staff)
+		}
+		logs, err := service.GetModLogs(adminCtx, entity.SliceQuery{After: algo.NullString(""), Limit: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(logs.Logs) != 3 || logs.Logs[2].Action != entity.ModActionSetUserRole {
+			t.Errorf("GetModLogs() = %+v, want 3 logs of setting role", logs.Logs)
+		}
+	})
+}
+
 func TestService_PubThread(t *testing.T) {
 	mainTags := []string{"MainA", "MainB", "MainC"}
 	service, _ := initEnv(t, mainTags...)
@@ -521,6 +768,7 @@ func TestService_PubThread(t *testing.T) {
 					Anonymous: true,
 					UserID:    user.ID,
 					Author:    user.ID.ToBase64String(),
+					IsOP:      true,
 				},
 				Title:   algo.NullString("title"),
 				Content: "content",
@@ -544,6 +792,7 @@ func TestService_PubThread(t *testing.T) {
 					UserID:    user.ID,
 					Anonymous: false,
 					Author:    *user.Name,
+					IsOP:      true,
 				},
 				Title:   nil,
 				Content: "content1",
@@ -581,6 +830,7 @@ func TestService_PubThread(t *testing.T) {
 					Guest:     true,
 					Anonymous: true,
 					Author:    gUser.ID.ToBase64String(),
+					IsOP:      true,
 				},
 				Title:   nil,
 				Content: "content",
@@ -614,6 +864,148 @@ func TestService_PubThread(t *testing.T) {
 	}
 }
 
+func TestService_EditThread(t *testing.T) {
+	service, ctx := initEnv(t, "MainA", "MainB", "MainC")
+
+	thread, authorCtx := pubThread(t, service, testUser{email: "a@example.com"})
+	_, otherCtx := loginUser(t, service, testUser{email: "b@example.com"})
+	mod, _ := loginUser(t, service, testUser{email: "mod@example.com"})
+	mod.Role = entity.RoleMod
+	if _, err := service.Repo.User.Update(ctx, mod); err != nil {
+		t.Fatal(err)
+	}
+	_, modCtx := loginUser(t, service, testUser{email: "mod@example.com"})
+
+	t.Run("not author", func(t *testing.T) {
+		if _, err := service.EditThread(otherCtx, thread.ID, nil, "edited"); !errors.Is(err, errors.Permission) {
+			t.Errorf("EditThread() error = %v, want Permission", err)
+		}
+	})
+	t.Run("edit twice", func(t *testing.T) {
+		if _, err := service.EditThread(authorCtx, thread.ID, algo.NullString("title 1"), "content 1"); err != nil {
+			t.Fatal(err)
+		}
+		edited, err := service.EditThread(authorCtx, thread.ID, algo.NullString("title 2"), "content 2")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if algo.NullToString(edited.Title) != "title 2" || edited.Content != "content 2" || edited.EditedAt == nil {
+			t.Errorf("EditThread() = %+v, want edited", edited)
+		}
+		got, err := service.GetThreadByID(authorCtx, thread.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(edited, got); diff != "" {
+			t.Errorf("GetThreadByID() diff: %s", diff)
+		}
+	})
+	t.Run("revisions", func(t *testing.T) {
+		revs, err := service.GetRevisions(authorCtx, thread.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if revs != nil {
+			t.Errorf("GetRevisions() = %+v, should be hidden for normal user", revs)
+		}
+		revs, err = service.GetRevisions(modCtx, thread.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var contents []string
+		for _, rev := range revs {
+			contents = append(contents, rev.Content)
+		}
+		if diff := cmp.Diff(contents, []string{"content 1", thread.Content}); diff != "" {
+			t.Errorf("GetRevisions() diff: %s", diff)
+		}
+	})
+	t.Run("blocked", func(t *testing.T) {
+		if _, err := service.BlockThread(modCtx, thread.ID, nil); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := service.EditThread(authorCtx, thread.ID, nil, "content 3"); !errors.Is(err, errors.BadParams) {
+			t.Errorf("EditThread() error = %v, want BadParams", err)
+		}
+		unblocked, err := service.UnblockThread(modCtx, thread.ID, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if unblocked.Content != "content 2" {
+			t.Errorf("UnblockThread() content = %v, want the edited one", unblocked.Content)
+		}
+	})
+}
+
+func TestService_WatchThread(t *testing.T) {
+	service, ctx := initEnv(t, "MainA", "MainB", "MainC")
+
+	thread, _ := pubThread(t, service, testUser{email: "a@example.com"})
+	author, _ := loginUser(t, service, testUser{email: "a@example.com"})
+	watcher, watcherCtx := loginUser(t, service, testUser{email: "w@example.com"})
+	replier, _ := loginUser(t, service, testUser{email: "r@example.com"})
+	quiet, quietCtx := loginUser(t, service, testUser{email: "q@example.com"})
+
+	newReplies := func(t *testing.T, user *entity.User) int {
+		noti, err := service.Repo.Noti.GetByKey(ctx, user.ID, entity.RepliedNotiKey(thread, user.ID))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if noti == nil {
+			return 0
+		}
+		return noti.Content.(entity.RepliedNoti).NewRepliesCount
+	}
+
+	t.Run("guest can not watch", func(t *testing.T) {
+		_, guestCtx := loginUser(t, service, testUser{})
+		if _, err := service.WatchThread(guestCtx, thread.ID); !errors.Is(err, errors.Permission) {
+			t.Errorf("WatchThread() error = %v, want Permission", err)
+		}
+	})
+	t.Run("watch", func(t *testing.T) {
+		if _, err := service.WatchThread(watcherCtx, thread.ID); err != nil {
+			t.Fatal(err)
+		}
+		watched, err := service.GetThreadWatched(watcherCtx, thread)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !watched {
+			t.Errorf("GetThreadWatched() = false, want true")
+		}
+	})
+	t.Run("disable auto watch", func(t *testing.T) {
+		user, err := service.SetAutoWatch(quietCtx, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if user.AutoWatch {
+			t.Errorf("SetAutoWatch() = %+v, want auto watch disabled", user)
+		}
+	})
+	t.Run("notify watchers", func(t *testing.T) {
+		pubPost(t, service, testUser{email: *replier.Email}, thread.ID)
+		pubPost(t, service, testUser{email: *quiet.Email}, thread.ID)
+		got := []int{newReplies(t, author), newReplies(t, watcher), newReplies(t, replier), newReplies(t, quiet)}
+		if diff := cmp.Diff(got, []int{2, 2, 1, 0}); diff != "" {
+			t.Errorf("new replies diff: %s", diff)
+		}
+	})
+	t.Run("unwatch", func(t *testing.T) {
+		if _, err := service.UnwatchThread(watcherCtx, thread.ID); err != nil {
+			t.Fatal(err)
+		}
+		pubPost(t, service, testUser{email: *replier.Email}, thread.ID)
+		if got := newReplies(t, watcher); got != 2 {
+			t.Errorf("new replies of watcher = %v, want 2", got)
+		}
+		if got := newReplies(t, author); got != 3 {
+			t.Errorf("new replies of author = %v, want 3", got)
+		}
+	})
+}
+
 func TestService_LockThread(t *testing.T) {
 	mainTags := []string{"MainA", "MainB", "MainC"}
 	service, ctx := initEnv(t, mainTags...)
@@ -630,7 +1022,7 @@ func TestService_LockThread(t *testing.T) {
 	_, modCtx := loginUser(t, service, testUser{email: "mod@example.com"})
 
 	t.Run("check thread in memory", func(t *testing.T) {
-		thread, err := service.LockThread(modCtx, oriThread.ID)
+		thread, err := service.LockThread(modCtx, oriThread.ID, nil)
 		if err != nil {
 			t.Fatal(errors.Wrap(err, "LockThread"))
 		}
@@ -680,7 +1072,7 @@ func TestService_BlockThread(t *testing.T) {
 	_, modCtx := loginUser(t, service, testUser{email: "mod@example.com"})
 
 	t.Run("check thread in memory", func(t *testing.T) {
-		thread, err := service.BlockThread(modCtx, oriThread.ID)
+		thread, err := service.BlockThread(modCtx, oriThread.ID, nil)
 		if err != nil {
 			t.Fatal(errors.Wrap(err, "BlockThread"))
 		}
@@ -701,6 +1093,209 @@ func TestService_BlockThread(t *testing.T) {
 	})
 }
 
+func TestService_UnblockThread(t *testing.T) {
+	service, ctx := initEnv(t, "MainA", "MainB", "MainC")
+
+	oriThread, _ := pubThread(t, service, testUser{email: "t@example.com"})
+	mod, _ := loginUser(t, service, testUser{email: "mod@example.com"})
+	mod.Role = entity.RoleMod
+	if _, err := service.Repo.User.Update(ctx, mod); err != nil {
+		t.Fatal(err)
+	}
+	_, modCtx := loginUser(t, service, testUser{email: "mod@example.com"})
+	if _, err := service.BlockThread(modCtx, oriThread.ID, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.LockThread(modCtx, oriThread.ID, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := service.UnblockThread(modCtx, oriThread.ID, nil); err != nil {
+		t.Fatal(errors.Wrap(err, "UnblockThread"))
+	}
+	if _, err := service.UnlockThread(modCtx, oriThread.ID, nil); err != nil {
+		t.Fatal(errors.Wrap(err, "UnlockThread"))
+	}
+	thread, err := service.GetThreadByID(modCtx, oriThread.ID)
+	if err != nil {
+		t.Fatal(errors.Wrap(err, "GetThreadByID"))
+	}
+	oriThread.CreatedAt = thread.CreatedAt
+	if diff := cmp.Diff(thread, oriThread); diff != "" {
+		t.Errorf("UnblockThread() not matched: %s", diff)
+	}
+}
+
+func TestService_TagScopedMod(t *testing.T) {
+	service, ctx := initEnv(t, "MainA", "MainB", "MainC")
+
+	threadA, _ := pubThreadWithTags(t, service, testUser{email: "a@example.com"}, "MainA", nil)
+	threadB, _ := pubThreadWithTags(t, service, testUser{email: "b@example.com"}, "MainB", nil)
+	postA, _ := pubPost(t, service, testUser{email: "p@example.com"}, threadA.ID)
+	postB, _ := pubPost(t, service, testUser{email: "p@example.com"}, threadB.ID)
+	mod, _ := loginUser(t, service, testUser{email: "mod@example.com"})
+	mod.TagRoles = []entity.TagRole{{Tag: "MainA", Role: entity.RoleMod}}
+	if _, err := service.Repo.User.Update(ctx, mod); err != nil {
+		t.Fatal(err)
+	}
+	_, modCtx := loginUser(t, service, testUser{email: "mod@example.com"})
+
+	t.Run("in scope", func(t *testing.T) {
+		if _, err := service.BlockPost(modCtx, postA.ID, nil); err != nil {
+			t.Errorf("BlockPost() error = %v", err)
+		}
+		if _, err := service.LockThread(modCtx, threadA.ID, nil); err != nil {
+			t.Errorf("LockThread() error = %v", err)
+		}
+		if _, err := service.EditTags(modCtx, threadA.ID, "MainA", []string{"SubA"}, nil); err != nil {
+			t.Errorf("EditTags() error = %v", err)
+		}
+		if _, err := service.BlockThread(modCtx, threadA.ID, nil); err != nil {
+			t.Errorf("BlockThread() error = %v", err)
+		}
+	})
+	t.Run("out of scope", func(t *testing.T) {
+		if _, err := service.BlockPost(modCtx, postB.ID, nil); !errors.Is(err, errors.Permission) {
+			t.Errorf("BlockPost() error = %v, want Permission", err)
+		}
+		if _, err := service.LockThread(modCtx, threadB.ID, nil); !errors.Is(err, errors.Permission) {
+			t.Errorf("LockThread() error = %v, want Permission", err)
+		}
+		if _, err := service.BlockThread(modCtx, threadB.ID, nil); !errors.Is(err, errors.Permission) {
+			t.Errorf("BlockThread() error = %v, want Permission", err)
+		}
+		if _, err := service.EditTags(modCtx, threadA.ID, "MainB", nil, nil); !errors.Is(err, errors.Permission) {
+			t.Errorf("EditTags() moving out of scope error = %v, want Permission", err)
+		}
+		if _, err := service.BanUser(modCtx, &postA.ID, nil, nil, nil); !errors.Is(err, errors.Permission) {
+			t.Errorf("BanUser() error = %v, want Permission", err)
+		}
+	})
+}
+
+func TestService_PinThread(t *testing.T) {
+	mainTags := []string{"MainA", "MainB", "MainC"}
+	service, ctx := initEnv(t, mainTags...)
+
+	tu := testUser{email: "a@example.com", name: "a"}
+	var threads []*entity.Thread
+	for i := 0; i < 4; i++ {
+		thread, _ := pubThreadWithTags(t, service, tu, "MainA", []string{"SubA"})
+		threads = append(threads, thread)
+	}
+	mod, _ := loginUser(t, service, testUser{email: "mod@example.com"})
+	mod.Role = entity.RoleMod
+	if _, err := service.Repo.User.Update(ctx, mod); err != nil {
+		t.Fatal(err)
+	}
+	_, modCtx := loginUser(t, service, testUser{email: "mod@example.com"})
+	_, userCtx := loginUser(t, service, tu)
+
+	listIDs := func(t *testing.T, tags []string, cursor string) ([]uid.UID, string) {
+		slice, err := service.SearchThreads(userCtx, tags, nil, entity.SliceQuery{After: algo.NullString(cursor), Limit: 2})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []uid.UID
+		for _, thread := range slice.Threads {
+			ids = append(ids, thread.ID)
+		}
+		return ids, slice.SliceInfo.LastCursor
+	}
+
+	t.Run("normal user can not pin", func(t *testing.T) {
+		if _, err := service.PinThread(userCtx, threads[0].ID, nil, nil); !errors.Is(err, errors.Permission) {
+			t.Errorf("PinThread() error = %v, want Permission", err)
+		}
+	})
+	t.Run("pin until past time", func(t *testing.T) {
+		until := time.Now().Add(-time.Hour)
+		if _, err := service.PinThread(modCtx, threads[0].ID, &until, nil); !errors.Is(err, errors.BadParams) {
+			t.Errorf("PinThread() error = %v, want BadParams", err)
+		}
+	})
+	t.Run("pinned thread at the top of first page", func(t *testing.T) {
+		until := time.Now().Add(time.Hour)
+		thread, err := service.PinThread(modCtx, threads[0].ID, &until, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !thread.Pinned || thread.PinnedUntil == nil {
+			t.Errorf("PinThread() = %+v, should be pinned", thread)
+		}
+		// pinned threads take places of others on the first page.
+		page1, cursor := listIDs(t, []string{"MainA"}, "")
+		page2, _ := listIDs(t, []string{"MainA"}, cursor)
+		want1 := []uid.UID{threads[0].ID, threads[3].ID}
+		want2 := []uid.UID{threads[2].ID, threads[1].ID}
+		if diff := cmp.Diff(page1, want1); diff != "" {
+			t.Errorf("first page diff: %s", diff)
+		}
+		if diff := cmp.Diff(page2, want2); diff != "" {
+			t.Errorf("second page diff: %s", diff)
+		}
+	})
+	t.Run("not pinned in sub tag", func(t *testing.T) {
+		page1, _ := listIDs(t, []string{"SubA"}, "")
+		if diff := cmp.Diff(page1, []uid.UID{threads[3].ID, threads[2].ID}); diff != "" {
+			t.Errorf("first page diff: %s", diff)
+		}
+	})
+	t.Run("unpin", func(t *testing.T) {
+		thread, err := service.UnpinThread(modCtx, threads[0].ID, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if thread.Pinned || thread.PinnedUntil != nil {
+			t.Errorf("UnpinThread() = %+v, should not be pinned", thread)
+		}
+		page1, _ := listIDs(t, []string{"MainA"}, "")
+		if diff := cmp.Diff(page1, []uid.UID{threads[3].ID, threads[2].ID}); diff != "" {
+			t.Errorf("first page diff: %s", diff)
+		}
+	})
+	t.Run("pinned threads are filtered", func(t *testing.T) {
+		normal, _ := pubThreadWithTags(t, service, tu, "MainB", nil)
+		pinned, _ := pubThreadWithTags(t, service, tu, "MainB", nil)
+		muted, _ := pubThreadWithTags(t, service, tu, "MainB", []string{"SubB"})
+		hidden, _ := pubThreadWithTags(t, service, tu, "MainB", nil)
+		blocked, _ := pubThreadWithTags(t, service, tu, "MainB", nil)
+		for _, thread := range []*entity.Thread{pinned, muted, hidden, blocked} {
+			if _, err := service.PinThread(modCtx, thread.ID, nil, nil); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if _, err := service.BlockThread(modCtx, blocked.ID, nil); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := service.MuteTag(userCtx, "SubB"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := service.HideThread(userCtx, hidden.ID); err != nil {
+			t.Fatal(err)
+		}
+		user, userCtx := loginUser(t, service, tu)
+		search := &entity.ThreadsSearch{
+			Tags:         []string{"MainB"},
+			Sort:         entity.ThreadSortBump,
+			SkipBlocked:  true,
+			ExcludedTags: user.ExcludedTags([]string{"MainB"}),
+			HiddenBy:     &user.ID,
+		}
+		slice, err := service.Repo.Thread.FindSlice(userCtx, search, entity.SliceQuery{After: algo.NullString(""), Limit: 2})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []uid.UID
+		for _, thread := range slice.Threads {
+			ids = append(ids, thread.ID)
+		}
+		if diff := cmp.Diff(ids, []uid.UID{pinned.ID, normal.ID}); diff != "" {
+			t.Errorf("first page diff: %s", diff)
+		}
+	})
+}
+
 func TestService_EditTags(t *testing.T) {
 	mainTags := []string{"MainA", "MainB", "MainC"}
 	service, ctx := initEnv(t, mainTags...)
@@ -718,7 +1313,7 @@ func TestService_EditTags(t *testing.T) {
 	_, modCtx := loginUser(t, service, testUser{email: "mod@example.com"})
 
 	t.Run("check thread in memory", func(t *testing.T) {
-		thread, err := service.EditTags(modCtx, oriThread.ID, oriThread.MainTag, oriThread.SubTags)
+		thread, err := service.EditTags(modCtx, oriThread.ID, oriThread.MainTag, oriThread.SubTags, nil)
 		if err != nil {
 			t.Fatal(errors.Wrap(err, "EditTags"))
 		}
@@ -739,15 +1334,148 @@ func TestService_EditTags(t *testing.T) {
 	})
 }
 
-func TestService_SearchThreads(t *testing.T) {
-	mainTags := []string{"MainA", "MainB", "MainC"}
-	service, _ := initEnv(t, mainTags...)
+func TestService_GetTimeline(t *testing.T) {
+	service, ctx := initEnv(t, "MainA", "MainB")
 
-	var threads []*entity.Thread
-	tu := testUser{email: "a@example.com", name: "a"}
-	for i := 0; i < 10; i++ {
-		var thread *entity.Thread
-		switch {
+	admin, _ := loginUser(t, service, testUser{email: "admin@example.com"})
+	admin.Role = entity.RoleAdmin
+	if _, err := service.Repo.User.Update(ctx, admin); err != nil {
+		t.Fatal(err)
+	}
+	_, adminCtx := loginUser(t, service, testUser{email: "admin@example.com"})
+	_, userCtx := loginUser(t, service, testUser{email: "u@example.com"})
+	if _, err := service.SyncUserTags(userCtx, []string{"MainA", "Sub1"}); err != nil {
+		t.Fatal(err)
+	}
+	_, userCtx = loginUser(t, service, testUser{email: "u@example.com"})
+
+	t1, _ := pubThreadWithTags(t, service, testUser{email: "a@example.com"}, "MainA", nil)
+	t2, _ := pubThreadWithTags(t, service, testUser{email: "a@example.com"}, "MainB", nil)
+	t3, _ := pubThreadWithTags(t, service, testUser{email: "a@example.com"}, "MainB", []string{"Sub1"})
+	t4, _ := pubThreadWithTags(t, service, testUser{email: "a@example.com"}, "MainA", nil)
+	if _, err := service.BlockThread(adminCtx, t4.ID, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.PinThread(adminCtx, t1.ID, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want []uid.UID
+	}{
+		{name: "subscribed tags", ctx: userCtx, want: []uid.UID{t3.ID, t1.ID}},
+		{name: "main tags without user", ctx: ctx, want: []uid.UID{t3.ID, t2.ID, t1.ID}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slice, err := service.GetTimeline(tt.ctx, entity.SliceQuery{After: algo.NullString(""), Limit: 10})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []uid.UID
+			for _, thread := range slice.Threads {
+				got = append(got, thread.ID)
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("GetTimeline() diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestService_MuteAndHide(t *testing.T) {
+	service, _ := initEnv(t, "MainA", "MainB")
+
+	user, userCtx := loginUser(t, service, testUser{email: "u@example.com"})
+	t1, _ := pubThreadWithTags(t, service, testUser{email: "a@example.com"}, "MainA", nil)
+	t2, _ := pubThreadWithTags(t, service, testUser{email: "a@example.com"}, "MainB", nil)
+	t3, _ := pubThreadWithTags(t, service, testUser{email: "a@example.com"}, "MainB", []string{"Sub1"})
+	if _, err := service.MuteTag(userCtx, "Sub1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.HideThread(userCtx, t2.ID); err != nil {
+		t.Fatal(err)
+	}
+	user, userCtx = loginUser(t, service, testUser{email: "u@example.com"})
+	if diff := cmp.Diff(user.MutedTags, []string{"Sub1"}); diff != "" {
+		t.Errorf("MutedTags diff: %s", diff)
+	}
+
+	threadIDs := func(slice *entity.ThreadSlice) []uid.UID {
+		var ids []uid.UID
+		for _, thread := range slice.Threads {
+			ids = append(ids, thread.ID)
+		}
+		return ids
+	}
+	query := entity.SliceQuery{After: algo.NullString(""), Limit: 10}
+	t.Run("search threads", func(t *testing.T) {
+		tests := []struct {
+			name string
+			tags []string
+			want []uid.UID
+		}{
+			{name: "muted and hidden", tags: []string{"MainA", "MainB"}, want: []uid.UID{t1.ID}},
+			{name: "muted tag requested", tags: []string{"Sub1"}, want: []uid.UID{t3.ID}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				slice, err := service.SearchThreads(userCtx, tt.tags, nil, query)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(threadIDs(slice), tt.want); diff != "" {
+					t.Errorf("SearchThreads() diff: %s", diff)
+				}
+			})
+		}
+	})
+	t.Run("timeline", func(t *testing.T) {
+		slice, err := service.GetTimeline(userCtx, query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(threadIDs(slice), []uid.UID{t1.ID}); diff != "" {
+			t.Errorf("GetTimeline() diff: %s", diff)
+		}
+	})
+	t.Run("hidden threads", func(t *testing.T) {
+		slice, err := service.GetUserHiddenThreads(userCtx, user, query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(threadIDs(slice), []uid.UID{t2.ID}); diff != "" {
+			t.Errorf("GetUserHiddenThreads() diff: %s", diff)
+		}
+	})
+	t.Run("unmute and unhide", func(t *testing.T) {
+		if _, err := service.UnmuteTag(userCtx, "Sub1"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := service.UnhideThread(userCtx, t2.ID); err != nil {
+			t.Fatal(err)
+		}
+		slice, err := service.SearchThreads(userCtx, []string{"MainA", "MainB"}, nil, query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(threadIDs(slice), []uid.UID{t3.ID, t2.ID, t1.ID}); diff != "" {
+			t.Errorf("SearchThreads() diff: %s", diff)
+		}
+	})
+}
+
+func TestService_SearchThreads(t *testing.T) {
+	mainTags := []string{"MainA", "MainB", "MainC"}
+	service, _ := initEnv(t, mainTags...)
+
+	var threads []*entity.Thread
+	tu := testUser{email: "a@example.com", name: "a"}
+	for i := 0; i < 10; i++ {
+		var thread *entity.Thread
+		switch {
 		case i < 3:
 			thread, _ = pubThreadWithTags(t, service, tu, "MainA", []string{"SubA"})
 		case i < 6:
@@ -854,7 +1582,7 @@ func TestService_SearchThreads(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := service.SearchThreads(tt.args.ctx, tt.args.tags, tt.args.query)
+			got, err := service.SearchThreads(tt.args.ctx, tt.args.tags, nil, tt.args.query)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Service.SearchThreads() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -873,6 +1601,45 @@ func TestService_SearchThreads(t *testing.T) {
 			}
 		})
 	}
+
+	pubPost(t, service, testUser{email: "p1@example.com"}, threads[1].ID)
+	pubPost(t, service, testUser{email: "p1@example.com"}, threads[0].ID)
+	pubPost(t, service, testUser{email: "p2@example.com"}, threads[0].ID)
+	sortTests := []struct {
+		sort entity.ThreadSort
+		want []*entity.Thread
+	}{
+		{sort: entity.ThreadSortBump, want: []*entity.Thread{threads[0], threads[1], threads[5], threads[4]}},
+		{sort: entity.ThreadSortNewest, want: []*entity.Thread{threads[5], threads[4], threads[3], threads[2]}},
+		{sort: entity.ThreadSortReplies, want: []*entity.Thread{threads[0], threads[1], threads[5], threads[4]}},
+		{sort: entity.ThreadSortHot, want: []*entity.Thread{threads[0], threads[1], threads[5], threads[4]}},
+	}
+	for _, tt := range sortTests {
+		t.Run(fmt.Sprintf("sort by %s", tt.sort), func(t *testing.T) {
+			sort := tt.sort
+			var got []*entity.Thread
+			cursor := ""
+			for page := 0; page < 2; page++ { // 2 threads per page, to check cursor
+				slice, err := service.SearchThreads(ctx, []string{"MainA"}, &sort, entity.SliceQuery{
+					After: algo.NullString(cursor),
+					Limit: 2,
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, slice.Threads...)
+				cursor = slice.SliceInfo.LastCursor
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Service.SearchThreads() got %v threads, want %v", len(got), len(tt.want))
+			}
+			for i := range got {
+				if got[i].ID != tt.want[i].ID {
+					t.Errorf("Service.SearchThreads()[%v] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
 }
 
 func TestService_GetThreadReplies(t *testing.T) {
@@ -1186,6 +1953,36 @@ func TestService_PubPost(t *testing.T) {
 			}
 		})
 	}
+	t.Run("stable anonymous id", func(t *testing.T) {
+		anonyThread, err := service.PubThread(userCtx1, entity.ThreadInput{
+			Anonymous: true,
+			Content:   "anonymous thread",
+			MainTag:   "MainA",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		pub := func(ctx context.Context, content string) *entity.Post {
+			p, err := service.PubPost(ctx, entity.PostInput{ThreadID: anonyThread.ID, Anonymous: true, Content: content})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return p
+		}
+		op1, op2 := pub(userCtx1, "op reply 1"), pub(userCtx1, "op reply 2")
+		g1, g2 := pub(userCtxG, "guest reply 1"), pub(userCtxG, "guest reply 2")
+		if op1.Author.Author != anonyThread.Author.Author || op2.Author.Author != anonyThread.Author.Author {
+			t.Errorf("OP should reuse anonymous id %s, got %s and %s",
+				anonyThread.Author.Author, op1.Author.Author, op2.Author.Author)
+		}
+		if !op1.Author.IsOP || g1.Author.IsOP {
+			t.Errorf("isOP of OP = %v, isOP of other = %v", op1.Author.IsOP, g1.Author.IsOP)
+		}
+		if g1.Author.Author != g2.Author.Author || g1.Author.Author == op1.Author.Author {
+			t.Errorf("anonymous id of guest should be stable and unique, got %s, %s, OP is %s",
+				g1.Author.Author, g2.Author.Author, op1.Author.Author)
+		}
+	})
 }
 
 func TestService_BlockPost(t *testing.T) {
@@ -1205,7 +2002,7 @@ func TestService_BlockPost(t *testing.T) {
 	_, modCtx := loginUser(t, service, testUser{email: "mod@example.com"})
 
 	t.Run("check post in memory", func(t *testing.T) {
-		post, err := service.BlockPost(modCtx, oriPost.ID)
+		post, err := service.BlockPost(modCtx, oriPost.ID, nil)
 		if err != nil {
 			t.Fatal(errors.Wrap(err, "BlockPost"))
 		}
@@ -1226,6 +2023,99 @@ func TestService_BlockPost(t *testing.T) {
 	})
 }
 
+func TestService_UnblockPost(t *testing.T) {
+	service, ctx := initEnv(t, "MainA", "MainB", "MainC")
+
+	thread, _ := pubThread(t, service, testUser{email: "t@example.com"})
+	oriPost, _ := pubPost(t, service, testUser{email: "p@example.com"}, thread.ID)
+	mod, _ := loginUser(t, service, testUser{email: "mod@example.com"})
+	mod.Role = entity.RoleMod
+	if _, err := service.Repo.User.Update(ctx, mod); err != nil {
+		t.Fatal(err)
+	}
+	_, modCtx := loginUser(t, service, testUser{email: "mod@example.com"})
+	_, userCtx := loginUser(t, service, testUser{email: "u@example.com"})
+	if _, err := service.BlockPost(modCtx, oriPost.ID, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("no permission", func(t *testing.T) {
+		if _, err := service.UnblockPost(userCtx, oriPost.ID, nil); !errors.Is(err, errors.Permission) {
+			t.Errorf("UnblockPost() error = %v, want Permission", err)
+		}
+	})
+	t.Run("restore content", func(t *testing.T) {
+		if _, err := service.UnblockPost(modCtx, oriPost.ID, nil); err != nil {
+			t.Fatal(err)
+		}
+		post, err := service.GetPostByID(modCtx, oriPost.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		oriPost.CreatedAt = post.CreatedAt
+		if diff := cmp.Diff(post, oriPost); diff != "" {
+			t.Errorf("UnblockPost() post matched: %s", diff)
+		}
+	})
+}
+
+func TestService_EditPost(t *testing.T) {
+	service, ctx := initEnv(t, "MainA", "MainB", "MainC")
+
+	thread, _ := pubThread(t, service, testUser{email: "a@example.com"})
+	post, guestCtx := pubPost(t, service, testUser{}, thread.ID)
+	_, otherCtx := loginUser(t, service, testUser{})
+	mod, _ := loginUser(t, service, testUser{email: "mod@example.com"})
+	mod.Role = entity.RoleMod
+	if _, err := service.Repo.User.Update(ctx, mod); err != nil {
+		t.Fatal(err)
+	}
+	_, modCtx := loginUser(t, service, testUser{email: "mod@example.com"})
+
+	t.Run("not author", func(t *testing.T) {
+		if _, err := service.EditPost(otherCtx, post.ID, "edited"); !errors.Is(err, errors.Permission) {
+			t.Errorf("EditPost() error = %v, want Permission", err)
+		}
+	})
+	t.Run("guest author", func(t *testing.T) {
+		edited, err := service.EditPost(guestCtx, post.ID, "edited")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if edited.Content != "edited" || edited.EditedAt == nil {
+			t.Errorf("EditPost() = %+v, want edited", edited)
+		}
+		revs, err := service.Repo.Revision.FindByTarget(guestCtx, post.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(revs) != 1 || revs[0].Content != post.Content || !revs[0].CreatedAt.Equal(post.CreatedAt) {
+			t.Errorf("revisions = %+v, want the original post", revs)
+		}
+	})
+	t.Run("duplicated", func(t *testing.T) {
+		if _, err := service.EditPost(guestCtx, post.ID, "edited"); !errors.Is(err, errors.Duplicated) {
+			t.Errorf("EditPost() error = %v, want Duplicated", err)
+		}
+	})
+	t.Run("out of edit window", func(t *testing.T) {
+		window := config.Get().EditWindow
+		config.Get().EditWindow = 0
+		defer func() { config.Get().EditWindow = window }()
+		if _, err := service.EditPost(guestCtx, post.ID, "edited again"); !errors.Is(err, errors.BadParams) {
+			t.Errorf("EditPost() error = %v, want BadParams", err)
+		}
+	})
+	t.Run("locked thread", func(t *testing.T) {
+		if _, err := service.LockThread(modCtx, thread.ID, nil); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := service.EditPost(guestCtx, post.ID, "edited in locked"); !errors.Is(err, errors.BadParams) {
+			t.Errorf("EditPost() error = %v, want BadParams", err)
+		}
+	})
+}
+
 func TestService_GetPostQuotedPosts(t *testing.T) {
 	mainTags := []string{"MainA", "MainB", "MainC"}
 	service, _ := initEnv(t, mainTags...)
@@ -1278,6 +2168,7 @@ func TestService_GetPostQuotedCount(t *testing.T) {
 	post1, ctx := pubPost(t, service, testUser{email: "1@example"}, thread.ID)
 	pubPost(t, service, testUser{email: "2@example"}, thread.ID, post1.ID)
 	pubPost(t, service, testUser{email: "3@example"}, thread.ID, post1.ID)
+	pubPost(t, service, testUser{email: "4@example"}, thread.ID, post1.ID, post1.ID)
 
 	type args struct {
 		ctx  context.Context
@@ -1295,7 +2186,7 @@ func TestService_GetPostQuotedCount(t *testing.T) {
 				ctx:  ctx,
 				post: post1,
 			},
-			want:    2,
+			want:    3,
 			wantErr: false,
 		},
 	}
@@ -1311,6 +2202,456 @@ func TestService_GetPostQuotedCount(t *testing.T) {
 			}
 		})
 	}
+	t.Run("with loaders", func(t *testing.T) {
+		got, err := service.GetPostQuotedCount(service.AttachLoaders(ctx), post1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != 3 {
+			t.Errorf("Service.GetPostQuotedCount() = %v, want %v", got, 3)
+		}
+	})
+}
+
+func TestService_SubscribeThreadReplies(t *testing.T) {
+	mainTags := []string{"MainA", "MainB", "MainC"}
+	service, _ := initEnv(t, mainTags...)
+
+	thread, ctx := pubThread(t, service, testUser{email: "t@example", name: "a"})
+	other, _ := pubThread(t, service, testUser{email: "o@example", name: "o"})
+
+	subCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	posts, err := service.SubscribeThreadReplies(subCtx, thread.ID)
+	if err != nil {
+		t.Fatal(errors.Wrap(err, "SubscribeThreadReplies"))
+	}
+	pubPost(t, service, testUser{email: "1@example"}, other.ID)
+	post, _ := pubPost(t, service, testUser{email: "2@example"}, thread.ID)
+
+	t.Run("receive reply of the thread", func(t *testing.T) {
+		select {
+		case got := <-posts:
+			if got == nil || got.ID != post.ID {
+				t.Errorf("SubscribeThreadReplies() got = %v, want %v", got, post)
+			}
+		case <-time.After(5 * time.Second):
+			t.Errorf("SubscribeThreadReplies() receive nothing")
+		}
+	})
+	t.Run("closed after context done", func(t *testing.T) {
+		cancel()
+		select {
+		case _, ok := <-posts:
+			if ok {
+				t.Errorf("SubscribeThreadReplies() should not receive post")
+			}
+		case <-time.After(5 * time.Second):
+			t.Errorf("SubscribeThreadReplies() channel is not closed")
+		}
+	})
+	t.Run("thread not found", func(t *testing.T) {
+		if _, err := service.SubscribeThreadReplies(ctx, uid.NewUID()); !errors.Is(err, errors.NotFound) {
+			t.Errorf("SubscribeThreadReplies() error = %v, want NotFound", err)
+		}
+	})
+}
+
+func TestService_Bookmark(t *testing.T) {
+	service, _ := initEnv(t, "MainA", "MainB", "MainC")
+
+	thread, _ := pubThread(t, service, testUser{email: "a@example.com"})
+	post1, _ := pubPost(t, service, testUser{email: "a@example.com"}, thread.ID)
+	post2, _ := pubPost(t, service, testUser{email: "a@example.com"}, thread.ID)
+
+	itemIDs := func(items []entity.BookmarkItem) []uid.UID {
+		var ids []uid.UID
+		for _, item := range items {
+			switch i := item.(type) {
+			case *entity.Thread:
+				ids = append(ids, i.ID)
+			case *entity.Post:
+				ids = append(ids, i.ID)
+			}
+		}
+		return ids
+	}
+
+	for _, tu := range []testUser{{email: "b@example.com"}, {}} {
+		user, ctx := loginUser(t, service, tu)
+		t.Run(string(user.Role), func(t *testing.T) {
+			if _, err := service.Bookmark(ctx, nil, nil); !errors.Is(err, errors.BadParams) {
+				t.Errorf("Bookmark() error = %v, want BadParams", err)
+			}
+			for _, args := range [][2]*uid.UID{{&thread.ID, nil}, {nil, &post1.ID}, {nil, &post2.ID}} {
+				if _, err := service.Bookmark(ctx, args[0], args[1]); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if _, err := service.Unbookmark(ctx, nil, &post1.ID); err != nil {
+				t.Fatal(err)
+			}
+			slice, err := service.GetUserBookmarks(ctx, user, entity.SliceQuery{After: algo.NullString(""), Limit: 1})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(itemIDs(slice.Items), []uid.UID{post2.ID}); diff != "" || !slice.SliceInfo.HasNext {
+				t.Errorf("first page diff: %s, hasNext: %v", diff, slice.SliceInfo.HasNext)
+			}
+			slice, err = service.GetUserBookmarks(ctx, user, entity.SliceQuery{After: &slice.SliceInfo.LastCursor, Limit: 1})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(itemIDs(slice.Items), []uid.UID{thread.ID}); diff != "" || slice.SliceInfo.HasNext {
+				t.Errorf("second page diff: %s, hasNext: %v", diff, slice.SliceInfo.HasNext)
+			}
+			for id, want := range map[uid.UID]bool{thread.ID: true, post1.ID: false, post2.ID: true} {
+				if got, err := service.GetBookmarked(ctx, id); err != nil || got != want {
+					t.Errorf("GetBookmarked(%v) = %v, %v, want %v", id, got, err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestService_Report(t *testing.T) {
+	service, ctx := initEnv(t, "MainA", "MainB", "MainC")
+
+	thread, _ := pubThread(t, service, testUser{email: "a@example.com"})
+	post, _ := pubPost(t, service, testUser{email: "p@example.com"}, thread.ID)
+	_, reporterCtx := loginUser(t, service, testUser{email: "r@example.com"})
+	_, guestCtx := loginUser(t, service, testUser{})
+	mod, _ := loginUser(t, service, testUser{email: "mod@example.com"})
+	mod.Role = entity.RoleMod
+	if _, err := service.Repo.User.Update(ctx, mod); err != nil {
+		t.Fatal(err)
+	}
+	_, modCtx := loginUser(t, service, testUser{email: "mod@example.com"})
+
+	pending := func(t *testing.T) []*entity.Report {
+		slice, err := service.GetReports(modCtx, nil, entity.SliceQuery{After: algo.NullString(""), Limit: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return slice.Reports
+	}
+
+	t.Run("report", func(t *testing.T) {
+		if _, err := service.ReportThread(reporterCtx, thread.ID, entity.ReportReasonOffTopic, nil); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := service.ReportPost(reporterCtx, post.ID, entity.ReportReasonSpam, algo.NullString("ads")); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := service.ReportPost(guestCtx, post.ID, entity.ReportReasonAbuse, nil); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := service.ReportPost(reporterCtx, post.ID, entity.ReportReasonAbuse, nil); !errors.Is(err, errors.Duplicated) {
+			t.Errorf("ReportPost() error = %v, want Duplicated", err)
+		}
+		if got := len(pending(t)); got != 3 {
+			t.Errorf("pending reports = %v, want 3", got)
+		}
+	})
+	t.Run("normal user can not see reports", func(t *testing.T) {
+		_, err := service.GetReports(reporterCtx, nil, entity.SliceQuery{After: algo.NullString(""), Limit: 10})
+		if !errors.Is(err, errors.Permission) {
+			t.Errorf("GetReports() error = %v, want Permission", err)
+		}
+	})
+	t.Run("resolve with actions", func(t *testing.T) {
+		var report *entity.Report
+		for _, r := range pending(t) {
+			if r.Reason == entity.ReportReasonSpam {
+				report = r
+			}
+		}
+		action := &entity.ReportAction{Block: algo.NullBool(true), Lock: algo.NullBool(true), BanUser: algo.NullBool(true)}
+		report, err := service.ResolveReport(modCtx, report.ID, action, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if report.Status != entity.ReportStatusResolved || report.HandledAt == nil {
+			t.Errorf("ResolveReport() = %+v, want resolved", report)
+		}
+		blocked, err := service.GetPostByID(modCtx, post.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		locked, err := service.GetThreadByID(modCtx, thread.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		banned, _ := loginUser(t, service, testUser{email: "p@example.com"})
+		if !blocked.Blocked || !locked.Locked || locked.Blocked || banned.Role != entity.RoleBanned {
+			t.Errorf("post blocked %v, thread locked %v, thread blocked %v, user role %v",
+				blocked.Blocked, locked.Locked, locked.Blocked, banned.Role)
+		}
+		if _, err := service.ResolveReport(modCtx, report.ID, nil, nil); !errors.Is(err, errors.BadParams) {
+			t.Errorf("ResolveReport() error = %v, want BadParams", err)
+		}
+	})
+	t.Run("dismiss", func(t *testing.T) {
+		for _, r := range pending(t) {
+			if _, err := service.DismissReport(modCtx, r.ID); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if got := len(pending(t)); got != 0 {
+			t.Errorf("pending reports = %v, want 0", got)
+		}
+		status := entity.ReportStatusDismissed
+		slice, err := service.GetReports(modCtx, &status, entity.SliceQuery{After: algo.NullString(""), Limit: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(slice.Reports) != 2 {
+			t.Errorf("dismissed reports = %v, want 2", len(slice.Reports))
+		}
+	})
+}
+
+func TestService_GetModLogs(t *testing.T) {
+	service, ctx := initEnv(t, "MainA", "MainB", "MainC")
+
+	thread, _ := pubThread(t, service, testUser{email: "a@example.com"})
+	post, _ := pubPost(t, service, testUser{email: "p@example.com"}, thread.ID)
+	mod, _ := loginUser(t, service, testUser{email: "mod@example.com"})
+	mod.Role = entity.RoleMod
+	if _, err := service.Repo.User.Update(ctx, mod); err != nil {
+		t.Fatal(err)
+	}
+	_, modCtx := loginUser(t, service, testUser{email: "mod@example.com"})
+	admin, _ := loginUser(t, service, testUser{email: "admin@example.com"})
+	admin.Role = entity.RoleAdmin
+	if _, err := service.Repo.User.Update(ctx, admin); err != nil {
+		t.Fatal(err)
+	}
+	_, adminCtx := loginUser(t, service, testUser{email: "admin@example.com"})
+
+	if _, err := service.BlockPost(modCtx, post.ID, algo.NullString("spam")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.LockThread(modCtx, thread.ID, nil); err != nil {
+		t.Fatal(err)
+	}
+	past := time.Now().Add(-time.Hour)
+	if _, err := service.PinThread(modCtx, thread.ID, &past, nil); !errors.Is(err, errors.BadParams) {
+		t.Fatalf("PinThread() error = %v, want BadParams", err)
+	}
+
+	query := entity.SliceQuery{After: algo.NullString(""), Limit: 10}
+	t.Run("mod can not view", func(t *testing.T) {
+		if _, err := service.GetModLogs(modCtx, query); !errors.Is(err, errors.Permission) {
+			t.Errorf("GetModLogs() error = %v, want Permission", err)
+		}
+	})
+	t.Run("admin", func(t *testing.T) {
+		slice, err := service.GetModLogs(adminCtx, query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		type log struct {
+			Action   entity.ModAction
+			TargetID uid.UID
+			Reason   *string
+			Before   string
+			After    string
+		}
+		var got []log
+		for _, l := range slice.Logs {
+			if l.ActorID != mod.ID {
+				t.Errorf("actor of %+v is not the mod", l)
+			}
+			got = append(got, log{l.Action, l.TargetID, l.Reason, l.Before, l.After})
+		}
+		want := []log{
+			{
+				Action:   entity.ModActionLockThread,
+				TargetID: thread.ID,
+				Before:   string(mustMarshal(t, thread.ModState())),
+			},
+			{
+				Action:   entity.ModActionBlockPost,
+				TargetID: post.ID,
+				Reason:   algo.NullString("spam"),
+				Before:   `{"blocked": false}`,
+				After:    `{"blocked": true}`,
+			},
+		}
+		thread.Lock()
+		want[0].After = string(mustMarshal(t, thread.ModState()))
+		if diff := cmp.Diff(got, want, cmp.Comparer(jsonEqual)); diff != "" {
+			t.Errorf("GetModLogs() diff: %s", diff)
+		}
+	})
+}
+
+func mustMarshal(t *testing.T, v interface{}) []byte {
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// jsonEqual compares JSON encoded strings, ignoring format and key order.
+func jsonEqual(lh, rh string) bool {
+	var l, r interface{}
+	if err := json.Unmarshal([]byte(lh), &l); err != nil {
+		return lh == rh
+	}
+	if err := json.Unmarshal([]byte(rh), &r); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(l, r)
+}
+
+func TestService_Search(t *testing.T) {
+	mainTags := []string{"MainA", "MainB", "MainC"}
+	service, ctx := initEnv(t, mainTags...)
+
+	_, userCtx := loginUser(t, service, testUser{email: "a@example.com", name: "a"})
+	pub := func(mainTag, title, content string) *entity.Thread {
+		thread, err := service.PubThread(userCtx, entity.ThreadInput{
+			Anonymous: true, MainTag: mainTag, Title: algo.NullString(title), Content: content,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return thread
+	}
+	reply := func(thread *entity.Thread, content string) *entity.Post {
+		post, err := service.PubPost(userCtx, entity.PostInput{ThreadID: thread.ID, Anonymous: true, Content: content})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return post
+	}
+	t1 := pub("MainA", "深渊讨论", "今天天气不错")
+	t2 := pub("MainB", "无题", "深渊里的讨论 100%")
+	p1 := reply(t1, "深渊讨论的回复")
+	reply(t1, "无关的回复")
+	blocked := reply(t2, "被屏蔽的深渊讨论")
+	blocked.Block()
+	if _, err := service.Repo.Post.Update(ctx, blocked); err != nil {
+		t.Fatal(err)
+	}
+	itemIDs := func(items []entity.SearchItem) map[uid.UID]bool {
+		ids := map[uid.UID]bool{}
+		for _, item := range items {
+			switch v := item.(type) {
+			case *entity.Thread:
+				ids[v.ID] = true
+			case *entity.Post:
+				ids[v.ID] = true
+			}
+		}
+		return ids
+	}
+
+	type args struct {
+		text string
+		tags []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []uid.UID
+		wantErr bool
+	}{
+		{
+			name: "chinese text, blocked excluded",
+			args: args{text: "深渊 讨论"},
+			want: []uid.UID{t1.ID, t2.ID, p1.ID},
+		},
+		{
+			name: "with tags",
+			args: args{text: "深渊", tags: []string{"MainA"}},
+			want: []uid.UID{t1.ID, p1.ID},
+		},
+		{
+			name: "escape like pattern",
+			args: args{text: "100%"},
+			want: []uid.UID{t2.ID},
+		},
+		{
+			name: "not matched",
+			args: args{text: "_"},
+			want: []uid.UID{},
+		},
+		{
+			name:    "empty text",
+			args:    args{text: " "},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := service.Search(userCtx, tt.args.text, tt.args.tags, entity.SliceQuery{
+				After: algo.NullString(""), Limit: 10,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.Search() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			gotIDs := itemIDs(got.Items)
+			if len(gotIDs) != len(tt.want) {
+				t.Errorf("Service.Search() got %v items, want %v", len(gotIDs), len(tt.want))
+			}
+			for _, id := range tt.want {
+				if !gotIDs[id] {
+					t.Errorf("Service.Search() should contain %v", id)
+				}
+			}
+		})
+	}
+	t.Run("paging", func(t *testing.T) {
+		var all []entity.SearchItem
+		cursor := ""
+		for {
+			got, err := service.Search(userCtx, "深渊", nil, entity.SliceQuery{After: algo.NullString(cursor), Limit: 1})
+			if err != nil {
+				t.Fatal(err)
+			}
+			all = append(all, got.Items...)
+			if !got.SliceInfo.HasNext {
+				break
+			}
+			cursor = got.SliceInfo.LastCursor
+		}
+		if ids := itemIDs(all); len(all) != 3 || len(ids) != 3 {
+			t.Errorf("Service.Search() paging got %v items", len(all))
+		}
+	})
+	t.Run("ranking", func(t *testing.T) {
+		if err := service.CheckSearch(ctx); err != nil {
+			t.Fatal(err)
+		}
+		// later items are less relevant, so the order can't come from ids.
+		thread := pub("MainC", "无题", "渊薮")
+		prefix := reply(thread, "渊薮之中")
+		inner := reply(thread, "在渊薮之中")
+		got, err := service.Search(userCtx, "渊薮", nil, entity.SliceQuery{After: algo.NullString(""), Limit: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var gotIDs []uid.UID
+		for _, item := range got.Items {
+			switch v := item.(type) {
+			case *entity.Thread:
+				gotIDs = append(gotIDs, v.ID)
+			case *entity.Post:
+				gotIDs = append(gotIDs, v.ID)
+			}
+		}
+		if diff := cmp.Diff(gotIDs, []uid.UID{thread.ID, prefix.ID, inner.ID}); diff != "" {
+			t.Errorf("Service.Search() order diff: %s", diff)
+		}
+	})
 }
 
 func TestService_SearchTags(t *testing.T) {
@@ -1343,15 +2684,15 @@ func TestService_SearchTags(t *testing.T) {
 				limit: algo.NullInt(9),
 			},
 			want: []*entity.Tag{
-				{Name: "MainA", IsMain: true},
-				{Name: "MainB", IsMain: true},
-				{Name: "MainC", IsMain: true},
-				{Name: "Sub14", IsMain: false},
-				{Name: "Sub15", IsMain: false},
-				{Name: "Sub16", IsMain: false},
-				{Name: "Sub24", IsMain: false},
-				{Name: "Sub25", IsMain: false},
-				{Name: "Sub26", IsMain: false},
+				{Name: "MainA", IsMain: true, ThreadCount: 2},
+				{Name: "MainB", IsMain: true, ThreadCount: 2},
+				{Name: "MainC", IsMain: true, ThreadCount: 2},
+				{Name: "Sub14", IsMain: false, ThreadCount: 1},
+				{Name: "Sub15", IsMain: false, ThreadCount: 1},
+				{Name: "Sub16", IsMain: false, ThreadCount: 1},
+				{Name: "Sub24", IsMain: false, ThreadCount: 1},
+				{Name: "Sub25", IsMain: false, ThreadCount: 1},
+				{Name: "Sub26", IsMain: false, ThreadCount: 1},
 			},
 			ignoreOrder: true,
 		},
@@ -1363,9 +2704,9 @@ func TestService_SearchTags(t *testing.T) {
 				limit: algo.NullInt(10),
 			},
 			want: []*entity.Tag{
-				{Name: "MainC", IsMain: true},
-				{Name: "MainB", IsMain: true},
-				{Name: "MainA", IsMain: true},
+				{Name: "MainC", IsMain: true, ThreadCount: 2},
+				{Name: "MainB", IsMain: true, ThreadCount: 2},
+				{Name: "MainA", IsMain: true, ThreadCount: 2},
 			},
 		},
 		{
@@ -1376,12 +2717,12 @@ func TestService_SearchTags(t *testing.T) {
 				limit: algo.NullInt(10),
 			},
 			want: []*entity.Tag{
-				{Name: "Sub16", IsMain: false},
-				{Name: "Sub15", IsMain: false},
-				{Name: "Sub14", IsMain: false},
-				{Name: "Sub13", IsMain: false},
-				{Name: "Sub12", IsMain: false},
-				{Name: "Sub11", IsMain: false},
+				{Name: "Sub16", IsMain: false, ThreadCount: 1},
+				{Name: "Sub15", IsMain: false, ThreadCount: 1},
+				{Name: "Sub14", IsMain: false, ThreadCount: 1},
+				{Name: "Sub13", IsMain: false, ThreadCount: 1},
+				{Name: "Sub12", IsMain: false, ThreadCount: 1},
+				{Name: "Sub11", IsMain: false, ThreadCount: 1},
 			},
 		},
 	}
@@ -1393,7 +2734,7 @@ func TestService_SearchTags(t *testing.T) {
 				return
 			}
 			if !tt.ignoreOrder {
-				if diff := cmp.Diff(got, tt.want); diff != "" {
+				if diff := cmp.Diff(got, tt.want, tagStatCmp); diff != "" {
 					t.Errorf("Service.SearchTags() missmatch: %s", diff)
 				}
 			} else {
@@ -1405,6 +2746,344 @@ func TestService_SearchTags(t *testing.T) {
 	}
 }
 
+func TestService_SearchTagsRanked(t *testing.T) {
+	service, _ := initEnv(t, "MainA", "主页")
+
+	for _, sub := range []string{"原神", "原神同人", "Genshin Impact", "元素"} {
+		pubThreadWithTags(t, service, testUser{email: "a@example.com"}, "MainA", []string{sub})
+	}
+	_, ctx := loginUser(t, service, testUser{email: "a@example.com"})
+	if _, err := service.SyncUserTags(ctx, []string{"MainA", "订阅"}); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{query: "原神", want: []string{"原神", "原神同人"}},
+		{query: "yuan", want: []string{"元素", "原神同人", "原神"}},
+		{query: "ys", want: []string{"元素", "原神", "原神同人"}},
+		{query: "impact", want: []string{"Genshin Impact"}},
+		{query: "zhuye", want: []string{"主页"}},
+		{query: "dy", want: []string{"订阅"}},
+		{query: "%", want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			tags, err := service.SearchTags(ctx, algo.NullString(tt.query), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := []string{}
+			for _, tag := range tags {
+				got = append(got, tag.Name)
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("SearchTags(%q) diff: %s", tt.query, diff)
+			}
+		})
+	}
+}
+
+func TestService_TagInfo(t *testing.T) {
+	service, ctx := initEnv(t, "MainA", "MainB")
+
+	admin, _ := loginUser(t, service, testUser{email: "admin@example.com"})
+	admin.Role = entity.RoleAdmin
+	if _, err := service.Repo.User.Update(ctx, admin); err != nil {
+		t.Fatal(err)
+	}
+	_, adminCtx := loginUser(t, service, testUser{email: "admin@example.com"})
+	_, userCtx := loginUser(t, service, testUser{email: "u@example.com"})
+	if _, err := service.SyncUserTags(userCtx, []string{"MainA", "Sub1"}); err != nil {
+		t.Fatal(err)
+	}
+	thread, _ := pubThreadWithTags(t, service, testUser{email: "a@example.com"}, "MainA", []string{"Sub1"})
+	pubThreadWithTags(t, service, testUser{email: "a@example.com"}, "MainA", []string{"Sub2"})
+
+	t.Run("counts", func(t *testing.T) {
+		tag, err := service.GetTag(ctx, "MainA")
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := &entity.Tag{Name: "MainA", IsMain: true, ThreadCount: 2, SubscriberCount: 1}
+		if diff := cmp.Diff(tag, want, tagStatCmp); diff != "" {
+			t.Errorf("GetTag() diff: %s", diff)
+		}
+		if tag.ActiveAt == nil {
+			t.Errorf("GetTag() activeAt = nil, want time of last thread")
+		}
+		tag, err = service.GetTag(ctx, "MainB")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(tag, &entity.Tag{Name: "MainB", IsMain: true}); diff != "" {
+			t.Errorf("GetTag() main tag without threads diff: %s", diff)
+		}
+		if _, err := service.GetTag(ctx, "NoSuchTag"); !errors.Is(err, errors.NotFound) {
+			t.Errorf("GetTag() error = %v, want NotFound", err)
+		}
+	})
+	t.Run("edit tags of thread and subscription", func(t *testing.T) {
+		if _, err := service.EditTags(adminCtx, thread.ID, "MainB", []string{"Sub2"}, nil); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := service.DelUserSubbedTag(userCtx, "Sub1"); err != nil {
+			t.Fatal(err)
+		}
+		want := map[string][2]int{"MainA": {1, 1}, "MainB": {1, 0}, "Sub1": {0, 0}, "Sub2": {2, 0}}
+		for name, counts := range want {
+			tag, err := service.GetTag(ctx, name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tag.ThreadCount != counts[0] || tag.SubscriberCount != counts[1] {
+				t.Errorf("GetTag(%s) counts = (%v, %v), want %v", name, tag.ThreadCount, tag.SubscriberCount, counts)
+			}
+		}
+	})
+	t.Run("edit info", func(t *testing.T) {
+		if _, err := service.EditTag(userCtx, "MainA", algo.NullString("desc"), nil); !errors.Is(err, errors.Permission) {
+			t.Errorf("EditTag() error = %v, want Permission", err)
+		}
+		if _, err := service.EditTag(adminCtx, "Sub2", algo.NullString("desc"), nil); !errors.Is(err, errors.NotFound) {
+			t.Errorf("EditTag() sub tag error = %v, want NotFound", err)
+		}
+		tag, err := service.EditTag(adminCtx, "MainA", algo.NullString("desc"), algo.NullString("# rules"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if algo.NullToString(tag.Description) != "desc" || algo.NullToString(tag.Rules) != "# rules" {
+			t.Errorf("EditTag() = %+v, want description and rules set", tag)
+		}
+	})
+}
+
+func TestService_MainTags(t *testing.T) {
+	service, ctx := initEnv(t, "MainA", "MainB", "MainC")
+
+	admin, _ := loginUser(t, service, testUser{email: "admin@example.com"})
+	admin.Role = entity.RoleAdmin
+	if _, err := service.Repo.User.Update(ctx, admin); err != nil {
+		t.Fatal(err)
+	}
+	_, adminCtx := loginUser(t, service, testUser{email: "admin@example.com"})
+	_, userCtx := loginUser(t, service, testUser{email: "u@example.com"})
+	if _, err := service.MuteTag(userCtx, "MainA"); err != nil {
+		t.Fatal(err)
+	}
+	thread, _ := pubThreadWithTags(t, service, testUser{email: "a@example.com"}, "MainA", []string{"Sub1", "MainD"})
+
+	t.Run("no permission", func(t *testing.T) {
+		if _, err := service.AddMainTag(userCtx, "MainD"); !errors.Is(err, errors.Permission) {
+			t.Errorf("AddMainTag() error = %v, want Permission", err)
+		}
+	})
+	t.Run("add", func(t *testing.T) {
+		got, err := service.AddMainTag(adminCtx, "MainD")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, []string{"MainA", "MainB", "MainC", "MainD"}); diff != "" {
+			t.Errorf("AddMainTag() diff: %s", diff)
+		}
+		if _, err := service.AddMainTag(adminCtx, "MainD"); !errors.Is(err, errors.Duplicated) {
+			t.Errorf("AddMainTag() again error = %v, want Duplicated", err)
+		}
+	})
+	t.Run("rename", func(t *testing.T) {
+		if _, err := service.RenameMainTag(adminCtx, "MainB", "MainC"); !errors.Is(err, errors.Duplicated) {
+			t.Errorf("RenameMainTag() to existing error = %v, want Duplicated", err)
+		}
+		if _, err := service.RenameMainTag(adminCtx, "Sub1", "MainE"); !errors.Is(err, errors.NotFound) {
+			t.Errorf("RenameMainTag() sub tag error = %v, want NotFound", err)
+		}
+		got, err := service.RenameMainTag(adminCtx, "MainA", "MainE")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, []string{"MainE", "MainB", "MainC", "MainD"}); diff != "" {
+			t.Errorf("RenameMainTag() diff: %s", diff)
+		}
+		renamed, err := service.GetThreadByID(ctx, thread.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if renamed.MainTag != "MainE" {
+			t.Errorf("thread main tag = %v, want MainE", renamed.MainTag)
+		}
+		user, _ := loginUser(t, service, testUser{email: "u@example.com"})
+		if diff := cmp.Diff(user.MutedTags, []string{"MainE"}); diff != "" {
+			t.Errorf("user muted tags diff: %s", diff)
+		}
+	})
+	t.Run("retire", func(t *testing.T) {
+		got, err := service.RetireMainTag(adminCtx, "MainB")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, []string{"MainE", "MainC", "MainD"}); diff != "" {
+			t.Errorf("RetireMainTag() diff: %s", diff)
+		}
+		if _, err := service.RetireMainTag(adminCtx, "MainB"); !errors.Is(err, errors.NotFound) {
+			t.Errorf("RetireMainTag() again error = %v, want NotFound", err)
+		}
+		got, err = service.AddMainTag(adminCtx, "MainB")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, []string{"MainE", "MainC", "MainD", "MainB"}); diff != "" {
+			t.Errorf("AddMainTag() restore diff: %s", diff)
+		}
+	})
+	t.Run("reorder", func(t *testing.T) {
+		if _, err := service.ReorderMainTags(adminCtx, []string{"MainB", "MainC", "MainD", "MainD"}); !errors.Is(err, errors.BadParams) {
+			t.Errorf("ReorderMainTags() error = %v, want BadParams", err)
+		}
+		want := []string{"MainB", "MainC", "MainD", "MainE"}
+		got, err := service.ReorderMainTags(adminCtx, want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("ReorderMainTags() diff: %s", diff)
+		}
+	})
+}
+
+func TestService_MergeTags(t *testing.T) {
+	service, ctx := initEnv(t, "MainA", "MainB")
+
+	admin, _ := loginUser(t, service, testUser{email: "admin@example.com"})
+	admin.Role = entity.RoleAdmin
+	if _, err := service.Repo.User.Update(ctx, admin); err != nil {
+		t.Fatal(err)
+	}
+	_, adminCtx := loginUser(t, service, testUser{email: "admin@example.com"})
+	_, userCtx := loginUser(t, service, testUser{email: "u@example.com"})
+	if _, err := service.SyncUserTags(userCtx, []string{"MainA", "genshin", "Genshin"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.MuteTag(userCtx, "genshin"); err != nil {
+		t.Fatal(err)
+	}
+	guest, guestCtx := loginUser(t, service, testUser{})
+	if _, err := service.SyncUserTags(guestCtx, []string{"MainA", "genshin"}); err != nil {
+		t.Fatal(err)
+	}
+	thread, _ := pubThreadWithTags(t, service, testUser{email: "a@example.com"}, "MainA", []string{"genshin", "Genshin"})
+
+	t.Run("invalid", func(t *testing.T) {
+		if _, err := service.MergeTags(userCtx, "genshin", "Genshin"); !errors.Is(err, errors.Permission) {
+			t.Errorf("MergeTags() error = %v, want Permission", err)
+		}
+		if _, err := service.MergeTags(adminCtx, "MainA", "Genshin"); !errors.Is(err, errors.BadParams) {
+			t.Errorf("MergeTags() main tag error = %v, want BadParams", err)
+		}
+	})
+	t.Run("merge", func(t *testing.T) {
+		if _, err := service.MergeTags(adminCtx, "genshin", "Genshin"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := service.MergeTags(adminCtx, "Genshin", "原神"); err != nil {
+			t.Fatal(err)
+		}
+		got, err := service.GetThreadByID(ctx, thread.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got.SubTags, []string{"原神"}); diff != "" {
+			t.Errorf("thread sub tags diff: %s", diff)
+		}
+		user, _ := loginUser(t, service, testUser{email: "u@example.com"})
+		if diff := cmp.Diff(user.Tags, []string{"MainA", "原神"}); diff != "" {
+			t.Errorf("user tags diff: %s", diff)
+		}
+		if diff := cmp.Diff(user.MutedTags, []string{"原神"}); diff != "" {
+			t.Errorf("user muted tags diff: %s", diff)
+		}
+		guest, err = service.Repo.User.GetByID(ctx, guest.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(guest.Tags, []string{"MainA", "原神"}); diff != "" {
+			t.Errorf("guest tags diff: %s", diff)
+		}
+		aliases, err := service.Repo.Tag.GetAliases(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(aliases, map[string]string{"genshin": "原神", "Genshin": "原神"}); diff != "" {
+			t.Errorf("aliases diff: %s", diff)
+		}
+	})
+	t.Run("alias as main tag", func(t *testing.T) {
+		if _, err := service.AddMainTag(adminCtx, "genshin"); !errors.Is(err, errors.BadParams) {
+			t.Errorf("AddMainTag() alias error = %v, want BadParams", err)
+		}
+		if _, err := service.RenameMainTag(adminCtx, "MainB", "Genshin"); !errors.Is(err, errors.BadParams) {
+			t.Errorf("RenameMainTag() to alias error = %v, want BadParams", err)
+		}
+	})
+	t.Run("apply aliases on input", func(t *testing.T) {
+		thread, _ := pubThreadWithTags(t, service, testUser{email: "a@example.com"}, "MainB", []string{"genshin", "原神"})
+		if diff := cmp.Diff(thread.SubTags, []string{"原神"}); diff != "" {
+			t.Errorf("PubThread() sub tags diff: %s", diff)
+		}
+		_, userCtx := loginUser(t, service, testUser{email: "b@example.com"})
+		user, err := service.AddUserSubbedTag(userCtx, "Genshin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(strings.Join(user.Tags, ","), "原神") {
+			t.Errorf("AddUserSubbedTag() tags = %v, want contain 原神", user.Tags)
+		}
+	})
+}
+
+func TestService_TrendingTags(t *testing.T) {
+	service, ctx := initEnv(t, "MainA", "MainB")
+
+	hot, _ := pubThreadWithTags(t, service, testUser{email: "a@example.com"}, "MainA", []string{"Hot", "Rust"})
+	for i := 0; i < 3; i++ {
+		pubPost(t, service, testUser{email: "b@example.com"}, hot.ID)
+	}
+	pubThreadWithTags(t, service, testUser{email: "a@example.com"}, "MainB", []string{"Go"})
+	pubThreadWithTags(t, service, testUser{email: "a@example.com"}, "MainB", []string{"Go"})
+	pubThreadWithTags(t, service, testUser{email: "a@example.com"}, "MainA", []string{"Cold"})
+	if err := service.RefreshTrendingTags(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("trending", func(t *testing.T) {
+		got, err := service.GetTrendingTags(ctx, entity.TrendingWindowDay, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []*entity.Tag{{Name: "Rust"}, {Name: "Hot"}, {Name: "Go"}, {Name: "Cold"}}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("GetTrendingTags() diff: %s", diff)
+		}
+		if _, err := service.GetTrendingTags(ctx, entity.TrendingWindowDay, algo.NullInt(1000)); !errors.Is(err, errors.BadParams) {
+			t.Errorf("GetTrendingTags() error = %v, want BadParams", err)
+		}
+	})
+	t.Run("recommended", func(t *testing.T) {
+		_, userCtx := loginUser(t, service, testUser{email: "u@example.com"})
+		if _, err := service.SyncUserTags(userCtx, []string{"MainB", "Cold"}); err != nil {
+			t.Fatal(err)
+		}
+		_, userCtx = loginUser(t, service, testUser{email: "u@example.com"})
+		got, err := service.GetRecommendedTags(userCtx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, []string{"Go", "Rust", "Hot"}); diff != "" {
+			t.Errorf("GetRecommendedTags() diff: %s", diff)
+		}
+	})
+}
+
 func TestService_GetUnreadNotiCount(t *testing.T) {
 	mainTags := []string{"MainA", "MainB", "MainC"}
 	service, ctx := initEnv(t, mainTags...)
@@ -1488,6 +3167,61 @@ func TestService_GetUnreadNotiCount(t *testing.T) {
 	}
 }
 
+func TestService_SubscribeUnreadNoti(t *testing.T) {
+	mainTags := []string{"MainA", "MainB", "MainC"}
+	service, ctx := initEnv(t, mainTags...)
+
+	user, userCtx := loginUser(t, service, testUser{email: "a@example.com"})
+	subCtx, cancel := context.WithCancel(userCtx)
+	defer cancel()
+	events, err := service.SubscribeUnreadNoti(subCtx)
+	if err != nil {
+		t.Fatal(errors.Wrap(err, "SubscribeUnreadNoti"))
+	}
+	receive := func(t *testing.T) *entity.UnreadNotiEvent {
+		select {
+		case event := <-events:
+			return event
+		case <-time.After(5 * time.Second):
+			t.Fatal("SubscribeUnreadNoti() receive nothing")
+		}
+		return nil
+	}
+
+	t.Run("global system noti", func(t *testing.T) {
+		noti, err := entity.NewSystemNoti("welcome!", "welcome to abyss", entity.SendToGroup(entity.AllUser))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := service.Repo.Noti.Insert(ctx, noti); err != nil {
+			t.Fatal(err)
+		}
+		event := receive(t)
+		if event.UnreadCount != 2 || event.Notification.Key != noti.Key {
+			t.Errorf("SubscribeUnreadNoti() got = %+v, want count 2 and noti %v", event, noti)
+		}
+	})
+	t.Run("new reply noti, and updated", func(t *testing.T) {
+		thread, _ := pubThread(t, service, testUser{email: *user.Email})
+		pubPost(t, service, testUser{email: "p@example.com"}, thread.ID)
+		event := receive(t)
+		if event.UnreadCount != 3 || event.Notification.Key != entity.RepliedNotiKey(thread, user.ID) {
+			t.Errorf("SubscribeUnreadNoti() got = %+v, want count 3 and replied noti", event)
+		}
+		pubPost(t, service, testUser{email: "p@example.com"}, thread.ID)
+		event = receive(t)
+		content, _ := event.Notification.Content.(entity.RepliedNoti)
+		if event.UnreadCount != 3 || content.NewRepliesCount != 2 {
+			t.Errorf("SubscribeUnreadNoti() got = %+v, want count 3 and 2 new replies", event)
+		}
+	})
+	t.Run("no user", func(t *testing.T) {
+		if _, err := service.SubscribeUnreadNoti(ctx); !errors.Is(err, errors.NoAuth) {
+			t.Errorf("SubscribeUnreadNoti() error = %v, want NoAuth", err)
+		}
+	})
+}
+
 func TestService_GetNotification(t *testing.T) {
 	mainTags := []string{"MainA", "MainB", "MainC"}
 	service, ctx := initEnv(t, mainTags...)
@@ -1585,6 +3319,7 @@ func TestService_GetNotification(t *testing.T) {
 								// user id won't return to frontend
 								Anonymous: q.Author.Anonymous,
 								Author:    q.Author.Author,
+								IsOP:      q.Author.IsOP,
 							},
 							Content: q.Content,
 						},
@@ -1594,6 +3329,7 @@ func TestService_GetNotification(t *testing.T) {
 								// user id won't return to frontend
 								Anonymous: p.Author.Anonymous,
 								Author:    p.Author.Author,
+								IsOP:      p.Author.IsOP,
 							},
 							Content: p.Content,
 						},
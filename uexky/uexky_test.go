@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"gitlab.com/abyss.club/uexky/lib/algo"
 	"gitlab.com/abyss.club/uexky/lib/config"
 	"gitlab.com/abyss.club/uexky/lib/errors"
@@ -160,6 +161,9 @@ func pubPost(t *testing.T, service *Service, u testUser, threadID uid.UID, quote
 	return post, ctx
 }
 
+// tagStatCmp ignores activeAt of tags, which is the time of test data.
+var tagStatCmp = cmpopts.IgnoreFields(entity.Tag{}, "ActiveAt")
+
 var tagSetCmp = cmp.Comparer(func(lh, rh []*entity.Tag) bool {
 	sort.SliceStable(lh, func(i, j int) bool {
 		return lh[i].Name < lh[j].Name
@@ -167,5 +171,5 @@ var tagSetCmp = cmp.Comparer(func(lh, rh []*entity.Tag) bool {
 	sort.SliceStable(rh, func(i, j int) bool {
 		return rh[i].Name < rh[j].Name
 	})
-	return cmp.Equal(lh, rh)
+	return cmp.Equal(lh, rh, tagStatCmp)
 })